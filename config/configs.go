@@ -3,6 +3,7 @@ package config
 import (
 	"log"
 	"os"
+	"strconv"
 
 	"github.com/joho/godotenv"
 	"go.mongodb.org/mongo-driver/v2/bson"
@@ -11,11 +12,35 @@ import (
 // GetDatabaseURL constructs the database URL from environment variables
 var DATABASEURL string
 
+// Directory for the shared ASCII art cache (survives restarts)
+var ASCIICACHEDIR string
+
+// How many images the whole server downloads and converts at once
+var IMAGEWORKERS = 4
+
 // add defauklt images url github user images Rtarun3606k
 var DEFAULTIMAGEURL = "https://avatars.githubusercontent.com/u/97576326?v=4"
 
+// Owner contact details (used by links and the "yank" action)
+var OwnerEmail = "r.tarunnayaka25042005@gmail.com"
+var ResumeURL = "https://tarunnayaka.me/resume.pdf"
+
+// Key layout: "default", "vim" or "emacs", plus single bindings on top
+// ("cards.up=k,up;global.quit=ctrl+c", see tui/keys.go for the names)
+var KEYMAP = "default"
+var KEYBINDINGS string
+
+// Starting theme ("auto" follows the terminal's background) and custom
+// themes ("ocean=base:dark,accent:#ff79c6;...", see tui/theme.go for the roles)
+var THEME = "auto"
+var THEMES string
+
+// Start sessions with mouse clicks on ("M" toggles it). Off by default:
+// while the app captures the mouse, plain drag no longer selects text.
+var MOUSE = false
+
 // all collection in modngodb v2
-var Collection = []string{"projects", "positions", "services", "blogs"}
+var Collection = []string{"projects", "positions", "services", "blogs", "forms"}
 
 // Messages
 type DataMsg struct {
@@ -28,6 +53,20 @@ type FormSubmittedMsg struct {
 	Success bool
 }
 
+// Msg to hide a toast once its timer runs out (ID guards against newer toasts)
+type ToastExpiredMsg struct {
+	ID int
+}
+
+// Msg sent once the terminal has stopped resizing (Seq guards against newer resizes)
+type ResizeSettledMsg struct {
+	Seq int
+}
+
+// Msg to advance an animated GIF by one frame (Seq guards against restarted playback)
+type DemoTickMsg struct {
+	Seq int
+}
 
 // DataMsg struct for passing data messages
 type AllMessages []DataMsg
@@ -40,6 +79,15 @@ func LoadEnv() {
 	}
 	log.Println("Environment variables loaded successfully")
 	DATABASEURL = getEnv("DATABASEURL", "mongodb://localhost:27017")
+	ASCIICACHEDIR = getEnv("ASCII_CACHE_DIR", ".cache/ascii")
+	if n, err := strconv.Atoi(getEnv("IMAGE_WORKERS", "4")); err == nil && n > 0 {
+		IMAGEWORKERS = n
+	}
+	KEYMAP = getEnv("KEYMAP", "default")
+	KEYBINDINGS = getEnv("KEYBINDINGS", "")
+	THEME = getEnv("THEME", "auto")
+	THEMES = getEnv("THEMES", "")
+	MOUSE, _ = strconv.ParseBool(getEnv("MOUSE", "false"))
 
 }
 
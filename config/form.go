@@ -0,0 +1,72 @@
+package config
+
+import (
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// Supported contact form field types
+const (
+	FieldText     = "text"
+	FieldEmail    = "email"
+	FieldTextarea = "textarea"
+	FieldRadio    = "radio"    // Pick exactly one option (left/right)
+	FieldSelect   = "select"   // Cycle through options (left/right)
+	FieldCheckbox = "checkbox" // Checklist, any number of options
+)
+
+// FormField describes one input on the contact form.
+// Name is the key the value is stored under on the contact document.
+type FormField struct {
+	Name        string   `bson:"name"`
+	Label       string   `bson:"label"`
+	Type        string   `bson:"type"`
+	Placeholder string   `bson:"placeholder"`
+	Required    bool     `bson:"required"`
+	Options     []string `bson:"options"`     // Choices for radio / select / checkbox
+	OptionsFrom string   `bson:"optionsFrom"` // "services" fills the choices from the services collection
+	CharLimit   int      `bson:"charLimit"`
+	Height      int      `bson:"height"` // Textarea rows
+	Half        bool     `bson:"half"`   // Share a row with the next half-width field
+	Default     string   `bson:"default"`
+}
+
+// FormDefinition is a document in the "forms" collection, e.g.
+//
+//	{ name: "contact", fields: [ { name: "company", label: "Company", type: "text" }, ... ] }
+type FormDefinition struct {
+	Name   string      `bson:"name"`
+	Fields []FormField `bson:"fields"`
+}
+
+// ContactFormName is the "forms" document used for the contact page
+const ContactFormName = "contact"
+
+// ContactFormFields is the built-in contact form, used when the "forms"
+// collection has no "contact" document
+var ContactFormFields = []FormField{
+	{Name: "firstName", Label: "First Name", Type: FieldText, Placeholder: "Jane", Required: true, CharLimit: 30, Half: true},
+	{Name: "lastName", Label: "Last Name", Type: FieldText, Placeholder: "Doe", CharLimit: 30, Half: true},
+	{Name: "email", Label: "Email", Type: FieldEmail, Placeholder: "your.email@example.com", Required: true, CharLimit: 50},
+	{Name: "type", Label: "You are a", Type: FieldRadio, Options: []string{"Professional", "Student"}, Default: "Professional"},
+	{Name: "serviceIds", Label: "Select Services (Optional • Space to toggle)", Type: FieldCheckbox, OptionsFrom: "services"},
+	{Name: "description", Label: "Message", Type: FieldTextarea, Placeholder: "Tell me about your project...", CharLimit: 500, Height: 5},
+}
+
+// ParseFormDefinition finds a form by name in the raw "forms" documents
+func ParseFormDefinition(data []bson.M, name string) ([]FormField, bool) {
+	for _, doc := range data {
+		raw, err := bson.Marshal(doc)
+		if err != nil {
+			continue
+		}
+
+		var def FormDefinition
+		if err := bson.Unmarshal(raw, &def); err != nil {
+			continue
+		}
+		if def.Name == name && len(def.Fields) > 0 {
+			return def.Fields, true
+		}
+	}
+	return nil, false
+}
@@ -8,6 +8,8 @@ import (
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
 	"log"
 	"portfolioTUI/config"
+	"reflect"
+	"strings"
 	"time"
 )
 
@@ -80,41 +82,95 @@ type ContactSchema struct {
 	Email           string        `bson:"email"`
 	Type            string        `bson:"type"`            // "professional" or "student"
 	Description     string        `bson:"description"`     // Maps to your Message input
-	ServiceId       *string       `bson:"serviceId"`       // Pointer allows sending 'null' to Mongo
+	ServiceId       *string       `bson:"serviceId"`       // First selected service (kept for older readers)
+	ServiceIds      []string      `bson:"serviceIds"`      // Every selected service
 	AppointmentDate interface{}   `bson:"appointmentDate"` // Explicitly nil
 	AppointmentTime interface{}   `bson:"appointmentTime"` // Explicitly nil
 	Name            string        `bson:"name"`            // Computed (First + Last)
 	CreatedAt       time.Time     `bson:"createdAt"`
+	Extra           bson.M        `bson:",inline"` // Fields added through the form schema (company, budget, ...)
 }
 
-// InsertContact saves the form data to the "contacts" collection
-func InsertContact(firstName, lastName, email, userType, msg, serviceIdStr string) error {
-	// FIX: Use GetCollection or Client directly.
-	// Assuming your main database name is "projects" (based on your earlier code).
-	// If your DB name is "portfolio" or something else, change "projects" below.
-	coll := GetCollection("contact", "contact")
+// contactKeys are the bson keys ContactSchema stores itself. A schema field
+// with one of these names can't go into Extra (the encoder would reject the
+// document as having the key twice).
+var contactKeys = func() map[string]bool {
+	keys := map[string]bool{}
+	t := reflect.TypeOf(ContactSchema{})
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("bson"), ",")
+		if name != "" {
+			keys[name] = true
+		}
+	}
+	return keys
+}()
 
-	// 2. Handle Service ID (Convert empty string to nil)
+// contactInputs are the ContactSchema keys NewContact takes from the form
+var contactInputs = map[string]bool{
+	"firstName": true, "lastName": true, "email": true,
+	"type": true, "description": true, "serviceIds": true,
+}
+
+// ReservedContactKey reports whether a form field with this name would be
+// lost: the document sets that key itself instead of taking it from the form
+func ReservedContactKey(key string) bool {
+	return contactKeys[key] && !contactInputs[key]
+}
+
+// NewContact builds the document that will be saved for a contact form.
+// It is built up front so the visitor can review exactly what gets stored
+// (CreatedAt is set when it's sent).
+// values maps form field names to a string or, for checklists, a []string.
+func NewContact(values map[string]interface{}) ContactSchema {
+	str := func(key string) string {
+		s, _ := values[key].(string)
+		return s
+	}
+
+	// 1. Handle Service IDs (No selection becomes null / empty array)
+	serviceIds, _ := values["serviceIds"].([]string)
 	var serviceId *string
-	if serviceIdStr != "" {
-		serviceId = &serviceIdStr
+	if len(serviceIds) > 0 {
+		serviceId = &serviceIds[0]
+	} else {
+		serviceIds = []string{}
+	}
+
+	// 2. Anything the schema adds beyond the known fields is stored as-is
+	// (except the keys the document already has)
+	extra := bson.M{}
+	for key, val := range values {
+		if contactKeys[key] {
+			continue
+		}
+		extra[key] = val
 	}
 
 	// 3. Prepare the Document
-	doc := ContactSchema{
-		FirstName:       firstName,
-		LastName:        lastName,
-		Email:           email,
-		Type:            userType,
-		Description:     msg,
+	return ContactSchema{
+		FirstName:       str("firstName"),
+		LastName:        str("lastName"),
+		Email:           str("email"),
+		Type:            strings.ToLower(str("type")), // "professional" or "student"
+		Description:     str("description"),
 		ServiceId:       serviceId,
+		ServiceIds:      serviceIds,
 		AppointmentDate: nil,
 		AppointmentTime: nil,
-		Name:            fmt.Sprintf("%s %s", firstName, lastName),
-		CreatedAt:       time.Now(),
+		Name:            strings.TrimSpace(fmt.Sprintf("%s %s", str("firstName"), str("lastName"))),
+		Extra:           extra,
 	}
+}
+
+// InsertContact saves the form data to the "contacts" collection
+func InsertContact(doc ContactSchema) error {
+	// FIX: Use GetCollection or Client directly.
+	// Assuming your main database name is "projects" (based on your earlier code).
+	// If your DB name is "portfolio" or something else, change "projects" below.
+	coll := GetCollection("contact", "contact")
 
-	// 4. Insert
+	// Insert
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
@@ -14,14 +14,12 @@ import (
 	"portfolioTUI/database"
 	"portfolioTUI/tui"
 
-	tea "github.com/charmbracelet/bubbletea"
-	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/ssh"
 	"github.com/charmbracelet/wish"
 	"github.com/charmbracelet/wish/activeterm"
 	"github.com/charmbracelet/wish/bubbletea"
 	"github.com/charmbracelet/wish/logging"
-	"github.com/muesli/termenv"
+	gossh "golang.org/x/crypto/ssh"
 )
 
 const (
@@ -45,16 +43,19 @@ func main() {
 	s, err := wish.NewServer(
 		wish.WithAddress(net.JoinHostPort(host, fmt.Sprintf("%d", port))),
 		wish.WithHostKeyPath(keyPath),
+		// Accept every visitor, but ask for their public key first so
+		// contact form drafts can be tied to it. Keyless clients fall
+		// back to an empty keyboard-interactive login.
+		wish.WithPublicKeyAuth(func(ctx ssh.Context, key ssh.PublicKey) bool {
+			return true
+		}),
+		wish.WithKeyboardInteractiveAuth(func(ctx ssh.Context, challenger gossh.KeyboardInteractiveChallenge) bool {
+			return true
+		}),
 		wish.WithMiddleware(
-			// --- SIMPLIFIED COLOR FIX ---
-			bubbletea.Middleware(func(s ssh.Session) (tea.Model, []tea.ProgramOption) {
-				// 1. Force Lipgloss to use 256 colors
-				// This overrides the auto-detection which fails over SSH
-				lipgloss.SetColorProfile(termenv.ANSI256)
-
-				// 2. Call your standard handler
-				return tui.TeaHandler(s)
-			}),
+			// Each session gets its own renderer (see tui.TeaHandler),
+			// using whatever colors the visitor's terminal reports
+			bubbletea.Middleware(tui.TeaHandler),
 			activeterm.Middleware(),
 			logging.Middleware(),
 		),
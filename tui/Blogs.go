@@ -8,12 +8,48 @@ import (
 	"github.com/charmbracelet/lipgloss"
 )
 
+// blogCardWidth is the width of one blog card (3 columns on wide screens)
+func blogCardWidth(width int) int {
+	var cardWidth int
+
+	if width > 120 {
+		// Calculate width: (Total / 3) - Spacing
+		cardWidth = (width / 3) - 4
+	} else {
+		cardWidth = width - 4
+	}
+
+	// Safety Check
+	if cardWidth < 30 {
+		cardWidth = 30
+	}
+	return cardWidth
+}
+
+// blogCardStyle is the border of one blog card (all cards are the same height)
+func (m Model) blogCardStyle(cardWidth int) lipgloss.Style {
+	return m.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(m.Theme.Border).
+		Padding(1).
+		Width(cardWidth).
+		Height(16) // Increased height slightly to fit content
+}
+
 func (m Model) renderBlogsSection(width int, limitOfCards bool) string {
+	content, _ := m.layoutBlogs(width, limitOfCards)
+	return content
+}
+
+// layoutBlogs renders the articles section, and where each card is
+func (m Model) layoutBlogs(width int, limitOfCards bool) (string, []cardRect) {
 	doc := strings.Builder{}
+	var rects []cardRect
 
 	// --- SECTION TITLE ---
-	title := sectionTitleStyle.Render("Latest Articles")
+	title := m.Styles.SectionTitle.Render("Latest Articles")
 	doc.WriteString(title + "\n\n")
+	top := lineCount(doc.String())
 
 	// --- 1. DETERMINE LIMIT & DATA ---
 	limitB := len(m.Blogs)
@@ -26,35 +62,17 @@ func (m Model) renderBlogsSection(width int, limitOfCards bool) string {
 
 	// --- 2. CALCULATE LAYOUT ---
 	isThreeColumn := width > 120
-	var cardWidth int
+	cardWidth := blogCardWidth(width)
 
-	if isThreeColumn {
-		// Calculate width: (Total / 3) - Spacing
-		cardWidth = (width / 3) - 4
-	} else {
-		cardWidth = width - 4
-	}
-
-	// Safety Check
-	if cardWidth < 30 {
-		cardWidth = 30
-	}
-
-	// Styles specific to Blog Card
-	blogCardStyle := lipgloss.NewStyle().
-		Border(lipgloss.RoundedBorder()).
-		BorderForeground(lipgloss.Color("63")). // Purple/Blue border
-		Padding(1).
-		Width(cardWidth).
-		Height(16) // Increased height slightly to fit content
+	blogCardStyle := m.blogCardStyle(cardWidth)
 
 	// Style for the Fallback Image Placeholder
-	fallbackImageStyle := lipgloss.NewStyle().
+	fallbackImageStyle := m.Renderer.NewStyle().
 		Width(cardWidth-4).
 		Height(5).
 		Align(lipgloss.Center, lipgloss.Center).
-		Background(lipgloss.Color("236")). // Dark Grey bg
-		Foreground(lipgloss.Color("245"))  // Light Grey text
+		Background(m.Theme.Surface).
+		Foreground(m.Theme.Subtext)
 
 	var blogCards []string
 
@@ -63,14 +81,12 @@ func (m Model) renderBlogsSection(width int, limitOfCards bool) string {
 		b := m.Blogs[i]
 
 		// -- Data Extraction --
-		id := utils.SafeString(b, "_id")
 		titleVal := utils.SafeString(b, "title")
 		author := utils.SafeString(b, "author")
 		views := utils.SafeString(b, "views")
 		dateStr := utils.SafeDate(b, "createdAt")
-		id = utils.SafeID(b, "_id")
 
-		liveLink := fmt.Sprintf("https://tarunnayaka.me/Blog/%s", id)
+		liveLink := blogLink(b)
 
 		// Date Formatting
 		if split := strings.Split(dateStr, "T"); len(split) > 0 {
@@ -85,12 +101,12 @@ func (m Model) renderBlogsSection(width int, limitOfCards bool) string {
 
 		// --- FIX 1: SAFE IMAGE HANDLING ---
 		// Instead of forcing .(string), check if it exists or use SafeString
-		imgContent := utils.SafeString(b, "ascii_art")
+		imgContent := m.art("blogs", i)
 
 		var imgBox string
 
 		if imgContent != "" {
-			imgBox = lipgloss.NewStyle().
+			imgBox = m.Renderer.NewStyle().
 				Width(cardWidth - 4).
 				Align(lipgloss.Center).
 				Render(imgContent)
@@ -102,24 +118,24 @@ func (m Model) renderBlogsSection(width int, limitOfCards bool) string {
 		// --- FIX 2: FIXED %S TYPO ---
 		// Changed %S to %s
 		metaText := fmt.Sprintf("%s • %s views", dateStr, views)
-		metaBox := lipgloss.NewStyle().
-			Foreground(lipgloss.Color("241")).
+		metaBox := m.Renderer.NewStyle().
+			Foreground(m.Theme.Subtext).
 			Width(cardWidth - 4).
 			Render(metaText)
 
 		// Title Style
-		titleBox := lipgloss.NewStyle().
+		titleBox := m.Renderer.NewStyle().
 			Bold(true).
-			Foreground(lipgloss.Color("205")). // Pink title
+			Foreground(m.Theme.Accent).
 			Width(cardWidth - 4).
 			Render(titleVal)
 
-		authorBox := lipgloss.NewStyle().
-			Foreground(lipgloss.Color("39")). // Blue author
+		authorBox := m.Renderer.NewStyle().
+			Foreground(m.Theme.Info).
 			Render("By " + author)
 
 		link := utils.MakeLink(" Live", liveLink)
-		linkHint := subtle.Render(link)
+		linkHint := m.Styles.Subtle.Render(link)
 
 		// Combine Content
 		contentBlock := lipgloss.JoinVertical(lipgloss.Left,
@@ -135,8 +151,12 @@ func (m Model) renderBlogsSection(width int, limitOfCards bool) string {
 		// We add a gap between image and text using a newline or margin
 		cardContent := lipgloss.JoinVertical(lipgloss.Left, imgBox, " ", contentBlock)
 
-		// Apply Border
-		finalCard := blogCardStyle.Render(cardContent)
+		// Apply Border (the selected card stands out)
+		style := blogCardStyle
+		if m.isFocused("blogs", i) {
+			style = style.BorderForeground(m.Theme.Focus)
+		}
+		finalCard := style.Render(cardContent)
 		blogCards = append(blogCards, finalCard)
 	}
 
@@ -158,8 +178,17 @@ func (m Model) renderBlogsSection(width int, limitOfCards bool) string {
 					renderedRow = lipgloss.JoinHorizontal(lipgloss.Top, renderedRow, "   ", currentRow[k])
 				}
 
+				// Where the cards of this row land once it's centered
+				x := centerOffset(width, lipgloss.Width(renderedRow))
+				for k, c := range currentRow {
+					rects = append(rects, cardRect{Kind: "blogs", Index: i - len(currentRow) + 1 + k,
+						X: x, Y: top, W: lipgloss.Width(c), H: lipgloss.Height(c)})
+					x += lipgloss.Width(c) + 3
+				}
+				top += lipgloss.Height(renderedRow)
+
 				// Center this row within the full width and add to our list of rows
-				rows = append(rows, lipgloss.PlaceHorizontal(width, lipgloss.Center, renderedRow))
+				rows = append(rows, m.Renderer.PlaceHorizontal(width, lipgloss.Center, renderedRow))
 
 				// Clear current row for the next batch
 				currentRow = []string{}
@@ -173,25 +202,31 @@ func (m Model) renderBlogsSection(width int, limitOfCards bool) string {
 
 	} else {
 		// Vertical Stack for small screens (Mobile)
+		for i, c := range blogCards {
+			rects = append(rects, cardRect{Kind: "blogs", Index: i, Y: top, W: lipgloss.Width(c), H: lipgloss.Height(c)})
+			top += lipgloss.Height(c)
+		}
 		doc.WriteString(lipgloss.JoinVertical(lipgloss.Center, blogCards...))
 	}
 
 	doc.WriteString("\n")
 
 	// --- 5. HINT TEXT ---
-	hintStyle := lipgloss.NewStyle().
+	hintStyle := m.Renderer.NewStyle().
 		Width(width).
 		Align(lipgloss.Center).
-		Foreground(lipgloss.Color("240")).
+		Foreground(m.Theme.Muted).
 		MarginTop(1)
 
 	if limitOfCards {
-		doc.WriteString(hintStyle.Render("Press (B) to view all articles • Enter to read"))
+		doc.WriteString(hintStyle.Render("Press (B) to view all articles and read them"))
 	} else {
-		doc.WriteString(hintStyle.Render("Press (Esc) to return home • Enter to read"))
+		k := m.Keys.Cards
+		doc.WriteString(hintStyle.Render(fmt.Sprintf("%s %s %s %s to pick an article • %s to read",
+			k.Up.Help().Key, k.Down.Help().Key, k.Left.Help().Key, k.Right.Help().Key, k.Open.Help().Key)))
 	}
 
 	doc.WriteString("\n")
 
-	return doc.String()
+	return doc.String(), rects
 }
@@ -2,17 +2,31 @@ package tui
 
 import (
 	"fmt"
+	"portfolioTUI/config"
 	"portfolioTUI/utils"
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
 )
 
-func (m Model) renderProjectsSection(width int) string {
+// featuredCardWidth is the width of a project card on the home page (two
+// a row, one on narrow screens)
+func featuredCardWidth(width int) int {
+	// Card Width = (Total / 2) - Spacing (border and margin take 3 more)
+	if stacked(width) {
+		return width - 4
+	}
+	return (width / 2) - 4
+}
+
+// layoutProjectsSection renders the featured projects of Home, and where
+// each card is
+func (m Model) layoutProjectsSection(width int) (string, []cardRect) {
 	doc := strings.Builder{}
+	var rects []cardRect
 	// --- SECTION 2: FEATURED PROJECTS (2 Cards) ---
-	title := sectionTitleStyle.Render("Featured Projects")
-	hintText := subtle.Align().UnsetBold().Render("Press P to view all projects")
+	title := m.Styles.SectionTitle.Render("Featured Projects")
+	hintText := m.Styles.Subtle.Align().UnsetBold().Render("Press P to view all projects")
 	doc.WriteString(title + "\n")
 
 	var projectCards []string
@@ -23,14 +37,10 @@ func (m Model) renderProjectsSection(width int) string {
 	}
 
 	// 1. Calculate Widths
-	// Card Width = (Total / 3) - Spacing
-	pCardWidth := (width / 2) - 2
-	if pCardWidth < 40 {
-		pCardWidth = 40
-	} // Safety minimum
-
-	// Image takes fixed 32 chars. Content gets the rest.
-	imageWidth := 32
+	pCardWidth := featuredCardWidth(width)
+
+	// Image column matches the generated art. Content gets the rest.
+	imageWidth, _ := cardImageSize("projects", width)
 	contentWidth := pCardWidth - imageWidth - 6 // -6 for padding/gap
 
 	for i := 0; i < limitP; i++ {
@@ -43,7 +53,7 @@ func (m Model) renderProjectsSection(width int) string {
 
 		// --- IMAGE HANDLING ---
 		logoStr := ""
-		if val, ok := p["ascii_art"].(string); ok && val != "" {
+		if val := m.art("projects", i); val != "" {
 			logoStr = val
 		} else if url, ok := p["imageUrl"].(string); ok && url != "" {
 			logoStr = "Loading..."
@@ -53,16 +63,17 @@ func (m Model) renderProjectsSection(width int) string {
 
 		// 2. TRUNCATE IMAGE HEIGHT (The Fix)
 		// Split lines, take max 10 lines, join back
+		// (Bitmap images can't be cut line by line, they keep their size)
 		lines := strings.Split(logoStr, "\n")
-		if len(lines) > 10 {
+		if len(lines) > 10 && !utils.IsBitmapArt(logoStr) {
 			logoStr = strings.Join(lines[:10], "\n")
 		}
 
 		// Render Image Box
-		logoBox := lipgloss.NewStyle().
+		logoBox := m.Renderer.NewStyle().
 			Width(imageWidth).
-			Align(lipgloss.Center).            // Center the ASCII horizontally in its box
-			Foreground(lipgloss.Color("240")). // Grey color for image
+			Align(lipgloss.Center). // Center the ASCII horizontally in its box
+			Foreground(m.Theme.Muted).
 			Render(logoStr)
 
 		// --- CONTENT HANDLING ---
@@ -71,7 +82,7 @@ func (m Model) renderProjectsSection(width int) string {
 		}
 
 		// Wrap description to fit the content column specifically
-		wrappedDesc := lipgloss.NewStyle().Width(contentWidth).Render(desc)
+		wrappedDesc := m.Renderer.NewStyle().Width(contentWidth).Render(desc)
 
 		// Create Links Row
 		links := ""
@@ -82,12 +93,12 @@ func (m Model) renderProjectsSection(width int) string {
 			links += utils.MakeLink(" Live", liveLink)
 		}
 
-		contentBox := lipgloss.NewStyle().
+		contentBox := m.Renderer.NewStyle().
 			Width(contentWidth).
 			Render(fmt.Sprintf("%s\n\n%s\n\n%s",
-				highlight.Render(name),
+				m.Styles.Highlight.Render(name),
 				wrappedDesc,
-				subtle.Render(links),
+				m.Styles.Subtle.Render(links),
 			))
 
 		// 3. JOIN COLUMNS
@@ -95,7 +106,11 @@ func (m Model) renderProjectsSection(width int) string {
 		gridOf2 := lipgloss.JoinHorizontal(lipgloss.Top, logoBox, "   ", contentBox)
 
 		// 4. RENDER CARD
-		card := cardStyle.
+		style := m.Styles.Card
+		if m.isFocused("projects", i) {
+			style = style.BorderForeground(m.Theme.Focus)
+		}
+		card := style.
 			Width(pCardWidth).
 			Height(12). // Fixed height for uniformity
 			Render(gridOf2)
@@ -103,22 +118,47 @@ func (m Model) renderProjectsSection(width int) string {
 		projectCards = append(projectCards, card)
 	}
 
-	// Join all cards horizontally
-	doc.WriteString(lipgloss.JoinHorizontal(lipgloss.Top, projectCards...) + "\n")
+	// Join the cards in rows, as many as fit (one on narrow screens)
+	perRow := 2
+	if stacked(width) {
+		perRow = 1
+	}
+	top := lineCount(doc.String())
+	var rows []string
+	for start := 0; start < len(projectCards); start += perRow {
+		row := projectCards[start:min(start+perRow, len(projectCards))]
+		x := 0
+		for i, c := range row {
+			// (Cards have a margin on their right)
+			rects = append(rects, cardRect{Kind: "projects", Index: start + i, X: x, Y: top, W: lipgloss.Width(c) - 1, H: lipgloss.Height(c)})
+			x += lipgloss.Width(c)
+		}
+		rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top, row...))
+		top += lipgloss.Height(rows[len(rows)-1])
+	}
+	doc.WriteString(lipgloss.JoinVertical(lipgloss.Left, rows...) + "\n")
 
 	// Hint Text
-	hintStyle := lipgloss.NewStyle().Width(width).Align(lipgloss.Center).Foreground(lipgloss.Color("240"))
+	hintStyle := m.Renderer.NewStyle().Width(width).Align(lipgloss.Center).Foreground(m.Theme.Muted)
 	hintText = hintStyle.Render(hintText)
 
 	doc.WriteString("\n" + hintText + "\n")
-	return doc.String()
+	return doc.String(), rects
 }
 
 func (m Model) renderServices(width int, limitOfCards bool) string {
+	content, _ := m.layoutServices(width, limitOfCards)
+	return content
+}
+
+// layoutServices renders the services section, and where each card is
+func (m Model) layoutServices(width int, limitOfCards bool) (string, []cardRect) {
 	doc := strings.Builder{}
+	var rects []cardRect
 
 	// 1. Title
-	doc.WriteString(sectionTitleStyle.Render("Services Provided") + "\n\n")
+	doc.WriteString(m.Styles.SectionTitle.Render("Services Provided") + "\n\n")
+	top := lineCount(doc.String())
 
 	// 2. Limit to 4 Cards
 	var limitS int
@@ -148,18 +188,18 @@ func (m Model) renderServices(width int, limitOfCards bool) string {
 
 	// 4. Style Definitions
 	// The outer box for the card
-	serviceCardStyle := lipgloss.NewStyle().
+	serviceCardStyle := m.Renderer.NewStyle().
 		Border(lipgloss.RoundedBorder()).
-		BorderForeground(lipgloss.Color("63")). // Purple border
+		BorderForeground(m.Theme.Border).
 		Padding(1).
 		MarginBottom(1).
 		Width(cardWidth)
 
 	// The Icon Box Style
-	iconStyle := lipgloss.NewStyle().
+	iconStyle := m.Renderer.NewStyle().
 		Width(6).
 		Align(lipgloss.Center).
-		Foreground(lipgloss.Color("205")) // Pink Icon
+		Foreground(m.Theme.Accent)
 
 	// 5. Loop and Build Cards
 	for i := 0; i < limitS; i++ {
@@ -183,19 +223,23 @@ func (m Model) renderServices(width int, limitOfCards bool) string {
 		}
 
 		// Build the Text Block
-		contentBlock := lipgloss.NewStyle().Width(contentWidth).Render(fmt.Sprintf(
+		contentBlock := m.Renderer.NewStyle().Width(contentWidth).Render(fmt.Sprintf(
 			"%s\n%s\n\n%s",
-			highlight.Render(title),
-			lipgloss.NewStyle().Foreground(lipgloss.Color("250")).Render(desc),
-			subtle.Render(fmt.Sprintf("%s • %s", price, timeframe)),
+			m.Styles.Highlight.Render(title),
+			m.Renderer.NewStyle().Foreground(m.Theme.Text).Render(desc),
+			m.Styles.Subtle.Render(fmt.Sprintf("%s • %s", price, timeframe)),
 		))
 
 		// Join Icon + Content
 		// using Top alignment ensures icon stays at the top if text is long
 		row := lipgloss.JoinHorizontal(lipgloss.Top, iconBox, "  ", contentBlock)
 
-		// Render the full card
-		cards = append(cards, serviceCardStyle.Render(row))
+		// Render the full card (the selected one gets the focus border)
+		style := serviceCardStyle
+		if m.isFocused("services", i) {
+			style = style.BorderForeground(m.Theme.Focus)
+		}
+		cards = append(cards, style.Render(row))
 	}
 
 	// 6. Layout Composition (Grid vs List)
@@ -204,25 +248,34 @@ func (m Model) renderServices(width int, limitOfCards bool) string {
 		// This is a simple implementation: just JoinHorizontal pairs
 		var rows []string
 		for i := 0; i < len(cards); i += 2 {
+			// (Card heights are minus the bottom margin)
+			rects = append(rects, cardRect{Kind: "services", Index: i, Y: top, W: lipgloss.Width(cards[i]), H: lipgloss.Height(cards[i]) - 1})
 			if i+1 < len(cards) {
 				// Pair of cards
+				rects = append(rects, cardRect{Kind: "services", Index: i + 1, X: lipgloss.Width(cards[i]) + 2, Y: top,
+					W: lipgloss.Width(cards[i+1]), H: lipgloss.Height(cards[i+1]) - 1})
 				rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top, cards[i], "  ", cards[i+1]))
 			} else {
 				// Single card leftover
 				rows = append(rows, cards[i])
 			}
+			top += lipgloss.Height(rows[len(rows)-1])
 		}
 		doc.WriteString(lipgloss.JoinVertical(lipgloss.Left, rows...) + "\n")
 	} else {
 		// Standard vertical list
+		for i, c := range cards {
+			rects = append(rects, cardRect{Kind: "services", Index: i, Y: top, W: lipgloss.Width(c), H: lipgloss.Height(c) - 1})
+			top += lipgloss.Height(c)
+		}
 		doc.WriteString(lipgloss.JoinVertical(lipgloss.Left, cards...) + "\n")
 	}
 
 	// 7. Add Hint Text
-	hintStyle := lipgloss.NewStyle().
+	hintStyle := m.Renderer.NewStyle().
 		Width(width).
 		Align(lipgloss.Center).
-		Foreground(lipgloss.Color("240")).
+		Foreground(m.Theme.Muted).
 		MarginTop(1)
 	if limitOfCards {
 		doc.WriteString(hintStyle.Render("For more services navigate to (S) .And for more information reach out via Contact (C) to discuss these services"))
@@ -233,26 +286,36 @@ func (m Model) renderServices(width int, limitOfCards bool) string {
 	}
 	doc.WriteString("\n")
 
-	return doc.String()
+	return doc.String(), rects
 }
 
 // main rendering function for Home tab
 func (m Model) renderHome(width int) string {
+	content, _ := m.layoutHome(width)
+	return content
+}
+
+// layoutHome renders the Home tab, and where the cards of its sections are
+func (m Model) layoutHome(width int) (string, []cardRect) {
 	doc := strings.Builder{}
+	var rects []cardRect
 
 	// --- SECTION 1: INTRO (Left) & CONNECT (Right) ---
 
-	// 1. Calculate widths
+	// 1. Calculate widths (on narrow screens the columns go one under the other)
 	leftWidth := int(float64(width)*0.6) - 4
 	rightWidth := width - leftWidth - 6
+	if stacked(width) {
+		leftWidth, rightWidth = width-4, width-4
+	}
 
 	// 2. Define Styles locally (if not global)
-	titleStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("205")).Bold(true).MarginBottom(1)
-	roleStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("63")).Bold(true)
-	textStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("252"))
-	keywordStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("35"))
-	statNumber := lipgloss.NewStyle().Foreground(lipgloss.Color("205")).Bold(true)
-	statLabel := lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
+	titleStyle := m.Renderer.NewStyle().Foreground(m.Theme.Accent).Bold(true).MarginBottom(1)
+	roleStyle := m.Renderer.NewStyle().Foreground(m.Theme.Primary).Bold(true)
+	textStyle := m.Renderer.NewStyle().Foreground(m.Theme.Text)
+	keywordStyle := m.Renderer.NewStyle().Foreground(m.Theme.Success)
+	statNumber := m.Renderer.NewStyle().Foreground(m.Theme.Accent).Bold(true)
+	statLabel := m.Renderer.NewStyle().Foreground(m.Theme.Muted)
 
 	// --- A. LEFT COLUMN (Intro) ---
 
@@ -261,7 +324,7 @@ func (m Model) renderHome(width int) string {
 		roleStyle.Render("Freelancer   | Cloud Architect   | Full-Stack Dev  \n"),
 	)
 
-	certTitle := lipgloss.NewStyle().Foreground(lipgloss.Color("63")).Bold(true).Underline(true)
+	certTitle := m.Renderer.NewStyle().Foreground(m.Theme.Primary).Bold(true).Underline(true)
 
 	education := fmt.Sprintf(`
 %s
@@ -269,7 +332,7 @@ func (m Model) renderHome(width int) string {
 %s PES  University
 `,
 		certTitle.Render("Education"),
-		lipgloss.NewStyle().Foreground(lipgloss.Color("35")).Render("🎓"),
+		m.Renderer.NewStyle().Foreground(m.Theme.Success).Render("🎓"),
 		statLabel.Render("   2023 - 2027"),
 	)
 
@@ -283,15 +346,15 @@ Specialized in Python, JS, and Cloud with 1+ years exp.`)
    %s Django   	%s FastAPI  	%s Express  	%s GraphQL
    %s Azure    	%s GCP      	%s AWS      	%s Docker
    %s Mongo    	%s PSQL     	%s MySQL    	%s Kafka `,
-		lipgloss.NewStyle().Bold(true).Underline(true).Render("Tech Stack\n"),
+		m.Renderer.NewStyle().Bold(true).Underline(true).Render("Tech Stack\n"),
 		keywordStyle.Render(""), keywordStyle.Render(""), keywordStyle.Render(""), keywordStyle.Render(""),
 		keywordStyle.Render(""), keywordStyle.Render(""), keywordStyle.Render(""), keywordStyle.Render(""),
 		keywordStyle.Render(""), keywordStyle.Render("⚡"), keywordStyle.Render(""), keywordStyle.Render(""),
 		keywordStyle.Render("ﴤ"), keywordStyle.Render(""), keywordStyle.Render(""), keywordStyle.Render(""),
 		keywordStyle.Render(""), keywordStyle.Render(""), keywordStyle.Render(""), keywordStyle.Render(""),
 	)
-	openToWork := lipgloss.NewStyle().Foreground(lipgloss.Color("46")).Bold(true).Render("\n🟢  OPEN TO WORK")
-	seeking := lipgloss.NewStyle().Foreground(lipgloss.Color("243")).Italic(true).Render("   Seeking: Backend, Cloud\n   & Full-Stack Roles")
+	openToWork := m.Renderer.NewStyle().Foreground(m.Theme.Success).Bold(true).Render("\n🟢  OPEN TO WORK")
+	seeking := m.Renderer.NewStyle().Foreground(m.Theme.Subtext).Italic(true).Render("   Seeking: Backend, Cloud\n   & Full-Stack Roles")
 	statusBlock := fmt.Sprintf("%s\n%s", openToWork, seeking)
 
 	loc := fmt.Sprintf("\n📍 %s", textStyle.Render("Bengaluru, India\n"))
@@ -299,7 +362,7 @@ Specialized in Python, JS, and Cloud with 1+ years exp.`)
 	introContent := lipgloss.JoinVertical(lipgloss.Left, header, bio, education, br, stack, loc, statusBlock)
 
 	// FIX 1: Render the Left Column into a Card!
-	leftCol := cardStyle.Copy().
+	leftCol := m.Styles.Card.Copy().
 		Width(leftWidth).
 		Render(introContent)
 
@@ -320,7 +383,7 @@ Specialized in Python, JS, and Cloud with 1+ years exp.`)
 	)
 
 	// 2. Certifications & Education (NEW SECTION to fill space)
-	// certText := lipgloss.NewStyle().Foreground(lipgloss.Color("252"))
+	// certText := m.Renderer.NewStyle().Foreground(lipgloss.Color("252"))
 
 	certsBlock := fmt.Sprintf(`
 %s
@@ -332,20 +395,20 @@ Specialized in Python, JS, and Cloud with 1+ years exp.`)
 %s Google Cloud Digital Leader
 `,
 		certTitle.Render("Certifications"),
-		lipgloss.NewStyle().Foreground(lipgloss.Color("214")).Render("📜"), // Gold scroll
-		lipgloss.NewStyle().Foreground(lipgloss.Color("214")).Render("📜"),
-		lipgloss.NewStyle().Foreground(lipgloss.Color("214")).Render("📜"),
-		lipgloss.NewStyle().Foreground(lipgloss.Color("214")).Render("📜"),
-		lipgloss.NewStyle().Foreground(lipgloss.Color("214")).Render("📜"),
-		lipgloss.NewStyle().Foreground(lipgloss.Color("214")).Render("📜"),
+		m.Renderer.NewStyle().Foreground(m.Theme.Warning).Render("📜"),
+		m.Renderer.NewStyle().Foreground(m.Theme.Warning).Render("📜"),
+		m.Renderer.NewStyle().Foreground(m.Theme.Warning).Render("📜"),
+		m.Renderer.NewStyle().Foreground(m.Theme.Warning).Render("📜"),
+		m.Renderer.NewStyle().Foreground(m.Theme.Warning).Render("📜"),
+		m.Renderer.NewStyle().Foreground(m.Theme.Warning).Render("📜"),
 	)
 
 	// 3. Button-Style Links (Takes up more visual weight)
 	// Define a "Button" style
-	btnStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("232")). // Dark text
-		Background(lipgloss.Color("250")). // Light background
-		Padding(0, 1)                      // Make it chunky
+	btnStyle := m.Renderer.NewStyle().
+		Foreground(m.Theme.Surface).
+		Background(m.Theme.Text).
+		Padding(0, 1) // Make it chunky
 
 	// Create styled buttons
 	resumeBtn := btnStyle.Render("📄  Download Resume")
@@ -354,10 +417,10 @@ Specialized in Python, JS, and Cloud with 1+ years exp.`)
 	emailBtn := btnStyle.Render("  Email Me       ")
 
 	// Wrap them in actual links
-	resumeLink := utils.MakeLink(resumeBtn, "https://tarunnayaka.me/resume.pdf")
+	resumeLink := utils.MakeLink(resumeBtn, config.ResumeURL)
 	blogLink := utils.MakeLink(blogBtn, "https://medium.com/@r.tarunnayaka25042005")
 	portfolioLink := utils.MakeLink(portfolioBtn, "https://tarunnayaka.me")
-	emailLink := utils.MakeLink(emailBtn, "mailto:r.tarunnayaka25042005@gmail.com")
+	emailLink := utils.MakeLink(emailBtn, "mailto:"+config.OwnerEmail)
 	// Col 1: Resume & Portfolio
 	col1 := lipgloss.JoinVertical(lipgloss.Left, resumeLink, "\n", portfolioLink)
 	// Col 2: Blog & Email
@@ -367,45 +430,54 @@ Specialized in Python, JS, and Cloud with 1+ years exp.`)
 	linksBlock := lipgloss.JoinHorizontal(lipgloss.Top, col1, "   ", col2)
 	// --- COMBINE RIGHT COLUMN ---
 	rightContent := lipgloss.JoinVertical(lipgloss.Left,
-		sectionTitleStyle.Render("At a Glance"),
+		m.Styles.SectionTitle.Render("At a Glance"),
 		statsBlock,
 		certsBlock, // Added the new Certs block here
-		sectionTitleStyle.Render("Quick Links"),
+		m.Styles.SectionTitle.Render("Quick Links"),
 		linksBlock,
 	)
 
-	rightCol := cardStyle.Copy().
+	rightCol := m.Styles.Card.Copy().
 		Width(rightWidth).
 		Render(rightContent)
 
 	topSection := lipgloss.JoinHorizontal(lipgloss.Top, leftCol, rightCol)
+	if stacked(width) {
+		topSection = lipgloss.JoinVertical(lipgloss.Left, leftCol, rightCol)
+	}
 	doc.WriteString(topSection + "\n")
 
 	//projects section ----------------------------------------------------------------------------------------
 	// --- SECTION 2: FEATURED PROJECTS (3 Cards) ---
-	doc.WriteString(m.renderProjectsSection(width))
+	section, sectionRects := m.layoutProjectsSection(width)
+	rects = append(rects, offsetRects(sectionRects, 0, lineCount(doc.String()))...)
+	doc.WriteString(section)
 	// Join all project cards horizontally
 
 	//section for blogs
 	doc.WriteString("\n")
 	// --- SECTION 3: LATEST ARTICLES (3 Cards) ---
-	doc.WriteString(m.renderBlogsSection(width, true))
+	section, sectionRects = m.layoutBlogs(width, true)
+	rects = append(rects, offsetRects(sectionRects, 0, lineCount(doc.String()))...)
+	doc.WriteString(section)
 	// Join all blog cards horizontally
 	doc.WriteString("\n")
 
 	//section 3: services offered --------------------------------------------------------------------------------
-	doc.WriteString(m.renderServices(width, true))
+	section, sectionRects = m.layoutServices(width, true)
+	rects = append(rects, offsetRects(sectionRects, 0, lineCount(doc.String()))...)
+	doc.WriteString(section)
 	// --- SECTION 4: CONTACT CTA ---
 	// A simple banner at the bottom
-	cta := lipgloss.NewStyle().
+	cta := m.Renderer.NewStyle().
 		Width(width - 2).
 		Border(lipgloss.DoubleBorder()).
-		BorderForeground(lipgloss.Color("205")).
+		BorderForeground(m.Theme.Accent).
 		Align(lipgloss.Center).
 		Padding(1).
 		Render("Have a project in mind? Press 'C' or Tab to visit the Contact page.")
 
 	doc.WriteString("\n" + cta + "\n")
 
-	return doc.String()
+	return doc.String(), rects
 }
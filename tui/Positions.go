@@ -9,14 +9,27 @@ import (
 	"go.mongodb.org/mongo-driver/v2/bson"
 )
 
+// positionLogoWidth is the logo column of an experience card:
+// a third of the card, between 16 and 32 cells
+func positionLogoWidth(width int) int {
+	return min(max((width-4)/3, 16), 32)
+}
+
 func (m Model) renderPosition(width int) string {
+	content, _ := m.layoutPositions(width)
+	return content
+}
+
+// layoutPositions renders the experience cards, and where each one is
+func (m Model) layoutPositions(width int) (string, []cardRect) {
 	doc := strings.Builder{}
+	var rects []cardRect
 
 	// --- 1. Define Layout Dimensions ---
 	// We split the card into: Left (Logo) + Right (Content)
 	// Total available width inside the border:
 	cardWidth := width - 4                    // Account for border/padding
-	logoWidth := 32                           // Fixed width for ASCII art column
+	logoWidth := positionLogoWidth(width)     // Width of the logo column
 	contentWidth := cardWidth - logoWidth - 3 // Remaining space (-3 for gap)
 
 	if contentWidth < 20 {
@@ -24,20 +37,20 @@ func (m Model) renderPosition(width int) string {
 	}
 
 	// --- 2. Define Local Styles ---
-	cardStyle := lipgloss.NewStyle().
+	cardStyle := m.Renderer.NewStyle().
 		Border(lipgloss.RoundedBorder()).
-		BorderForeground(lipgloss.Color("63")). // Purple Border
+		BorderForeground(m.Theme.Border).
 		Padding(1, 1).
 		MarginBottom(1).
 		Width(cardWidth)
 
 	// Style for the Meta row (Internship • IND • Remote)
-	metaStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("243")). // Dark Grey
+	metaStyle := m.Renderer.NewStyle().
+		Foreground(m.Theme.Subtext).
 		Italic(true)
 
 	// --- 3. Iterate Experience ---
-	for _, e := range m.Experience {
+	for i, e := range m.Experience {
 
 		// A. PREPARE DATA
 		role := utils.SafeString(e, "jobTitle")
@@ -78,12 +91,12 @@ func (m Model) renderPosition(width int) string {
 		// B. BUILD LEFT COLUMN (Logo)
 		// We use the ASCII art we generated earlier
 		logoStr := "   No\n  Image"
-		if val, ok := e["ascii_art"].(string); ok && val != "" {
+		if val := m.art("positions", i); val != "" {
 			logoStr = val
 		}
 
 		// Render Left Column
-		leftCol := lipgloss.NewStyle().
+		leftCol := m.Renderer.NewStyle().
 			Width(logoWidth).
 			Align(lipgloss.Center).
 			Render(logoStr)
@@ -91,7 +104,7 @@ func (m Model) renderPosition(width int) string {
 		// C. BUILD RIGHT COLUMN (Info)
 
 		// 1. Header: Role @ Company
-		header := fmt.Sprintf("%s @ %s", highlight.Render(role), highlight.Render(company))
+		header := fmt.Sprintf("%s @ %s", m.Styles.Highlight.Render(role), m.Styles.Highlight.Render(company))
 
 		// 2. Meta: Internship • IND • Remote
 		metaInfo := fmt.Sprintf("%s • %s • %s", empType, location, remoteStr)
@@ -107,7 +120,7 @@ func (m Model) renderPosition(width int) string {
 			for _, r := range items {
 				if str, ok := r.(string); ok {
 					// CRITICAL: Wrap text to 'contentWidth', not full screen width
-					wrapped := lipgloss.NewStyle().Width(contentWidth - 2).Render(str)
+					wrapped := m.Renderer.NewStyle().Width(contentWidth - 2).Render(str)
 					resBuilder.WriteString(fmt.Sprintf("• %s\n", wrapped))
 				}
 			}
@@ -123,7 +136,7 @@ func (m Model) renderPosition(width int) string {
 		rightBlock := lipgloss.JoinVertical(lipgloss.Left,
 			header,
 			metaStyle.Render(metaInfo),
-			subtle.Render(dateRow),
+			m.Styles.Subtle.Render(dateRow),
 			"\n", // Spacer
 			resBuilder.String(),
 		)
@@ -132,9 +145,16 @@ func (m Model) renderPosition(width int) string {
 		// Put Logo (Left) and Content (Right) side-by-side
 		row := lipgloss.JoinHorizontal(lipgloss.Top, leftCol, "   ", rightBlock)
 
-		// E. RENDER CARD
-		doc.WriteString(cardStyle.Render(row) + "\n")
+		// E. RENDER CARD (the selected one gets the focus border)
+		style := cardStyle
+		if m.isFocused("positions", i) {
+			style = style.BorderForeground(m.Theme.Focus)
+		}
+		card := style.Render(row)
+		// (Minus the bottom margin)
+		rects = append(rects, cardRect{Kind: "positions", Index: i, Y: lineCount(doc.String()), W: lipgloss.Width(card), H: lipgloss.Height(card) - 1})
+		doc.WriteString(card + "\n")
 	}
 
-	return doc.String()
+	return doc.String(), rects
 }
@@ -1,6 +1,7 @@
 package tui
 
 import (
+	"fmt"
 	"portfolioTUI/utils"
 	"strings"
 
@@ -9,12 +10,40 @@ import (
 )
 
 func (m Model) renderProject(width int) string {
-	doc := strings.Builder{}
+	content, _ := m.layoutProjects(width)
+	return content
+}
+
+// layoutProjects renders the tag bar and the project list, and where each
+// card is
+func (m Model) layoutProjects(width int) (string, []cardRect) {
+	bar := m.renderTagBar(width)
+	cards := m.projectCards(width)
+	if len(cards) == 0 && len(m.Projects) > 0 {
+		hint := fmt.Sprintf("No projects match these tags (%s, then %s to clear)", m.Keys.Cards.Tags.Help().Key, m.Keys.Tags.Clear.Help().Key)
+		return bar + m.Styles.Subtle.Render(hint) + "\n", nil
+	}
+
+	var rects []cardRect
+	y := lineCount(bar)
+	for k, index := range m.visibleProjects() {
+		h := lineCount(cards[k])
+		// Minus the bottom margin
+		rects = append(rects, cardRect{Kind: "projects", Index: index, Y: y, W: lipgloss.Width(cards[k]), H: h - 1})
+		y += h
+	}
+	return bar + strings.Join(cards, ""), rects
+}
+
+// projectCards renders the cards of the projects passing the tag filter
+// (each ends with a newline); the selected one gets the focus border
+func (m Model) projectCards(width int) []string {
+	var cards []string
 
 	// --- 1. Layout Dimensions ---
 	// Split: Left (Image) + Right (Content)
 	cardWidth := width - 4
-	imageWidth := 32 // Matches the width used in generation (30) + padding
+	imageWidth, _ := cardImageSize("projects", width) // Matches the generated art
 	contentWidth := cardWidth - imageWidth - 3
 
 	if contentWidth < 20 {
@@ -22,30 +51,19 @@ func (m Model) renderProject(width int) string {
 	}
 
 	// --- 2. Styles ---
-	cardStyle := lipgloss.NewStyle().
+	cardStyle := m.Renderer.NewStyle().
 		Border(lipgloss.RoundedBorder()).
-		BorderForeground(lipgloss.Color("205")). // Pink border for Projects
+		BorderForeground(m.Theme.Accent).
 		Padding(1, 1).
 		MarginBottom(1).
 		Width(cardWidth)
 
-	// Badge Style
-	featuredBadge := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("228")). // Yellow/Gold
-		Background(lipgloss.Color("63")).  // Purple bg
-		Bold(true).
-		Padding(0, 1).
-		SetString("★ FEATURED")
-
-	// Tag Style (e.g., [Python])
-	tagStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("123")). // Cyan text
-		Background(lipgloss.Color("237")). // Dark grey bg
-		Padding(0, 1).
-		MarginRight(1)
+	featuredBadge := m.Styles.FeaturedBadge
+	tagStyle := m.Styles.Tag
 
 	// --- 3. Iterate Projects ---
-	for _, p := range m.Projects {
+	for _, i := range m.visibleProjects() {
+		p := m.Projects[i]
 
 		// A. EXTRACT DATA
 		title := utils.SafeString(p, "title")
@@ -61,30 +79,23 @@ func (m Model) renderProject(width int) string {
 		//https://walkez.blob.core.windows.net/projects/1757574625363-Screenshotfrom2025-08-0114-25-42.png
 
 		// Tags (Handle bson.A)
-		var tagList []string
-		if rawTags, ok := p["tags"].(bson.A); ok {
-			for _, t := range rawTags {
-				if str, ok := t.(string); ok {
-					tagList = append(tagList, str)
-				}
-			}
-		}
+		tagList := projectTags(p)
 
 		// B. BUILD LEFT COLUMN (ASCII Art)
-	fallbackImageStyle := lipgloss.NewStyle().
+	fallbackImageStyle := m.Renderer.NewStyle().
 		Width(cardWidth-4).
 		Height(5).
 		Align(lipgloss.Center, lipgloss.Center).
-		Background(lipgloss.Color("236")). // Dark Grey bg
-		Foreground(lipgloss.Color("245"))  // Light Grey text
+		Background(m.Theme.Surface).
+		Foreground(m.Theme.Subtext)
 
 
-		imgContent := utils.SafeString(p, "ascii_art")
+		imgContent := m.art("projects", i)
 
 		var imgBox string
 
 		if imgContent != "" {
-			imgBox = lipgloss.NewStyle().
+			imgBox = m.Renderer.NewStyle().
 				Align(lipgloss.Center).
 				Render(imgContent)
 		} else {
@@ -93,7 +104,7 @@ func (m Model) renderProject(width int) string {
 		}
 
 		// Vertically center the image roughly if description is long
-		leftCol := lipgloss.NewStyle().
+		leftCol := m.Renderer.NewStyle().
 			Width(imageWidth).
 			Align(lipgloss.Center).
 			Render(imgBox)
@@ -101,7 +112,7 @@ func (m Model) renderProject(width int) string {
 		// C. BUILD RIGHT COLUMN
 
 		// 1. Title Row (Title + Featured Badge)
-		titleRow := highlight.Render(title)
+		titleRow := m.Styles.Highlight.Render(title)
 		if isFeatured {
 			titleRow += "  " + featuredBadge.String()
 		}
@@ -124,9 +135,9 @@ func (m Model) renderProject(width int) string {
 		tagsRow := lipgloss.JoinHorizontal(lipgloss.Top, styledTags...)
 
 		// 4. Description (Wrapped)
-		wrappedDesc := lipgloss.NewStyle().
+		wrappedDesc := m.Renderer.NewStyle().
 			Width(contentWidth).
-			Foreground(lipgloss.Color("252")). // White/Grey text
+			Foreground(m.Theme.Text).
 			Render(desc)
 
 		// Assemble Right Stack
@@ -145,8 +156,25 @@ func (m Model) renderProject(width int) string {
 		row := lipgloss.JoinHorizontal(lipgloss.Top, leftCol, "   ", rightStack)
 
 		// E. RENDER CARD
-		doc.WriteString(cardStyle.Render(row) + "\n")
+		style := cardStyle
+		if m.isFocused("projects", i) {
+			style = style.BorderForeground(m.Theme.Focus)
+		}
+		cards = append(cards, style.Render(row)+"\n")
 	}
 
-	return doc.String()
+	return cards
+}
+
+// projectTags reads a project's "tags" array
+func projectTags(p bson.M) []string {
+	var tagList []string
+	if rawTags, ok := p["tags"].(bson.A); ok {
+		for _, t := range rawTags {
+			if str, ok := t.(string); ok {
+				tagList = append(tagList, str)
+			}
+		}
+	}
+	return tagList
 }
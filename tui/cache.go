@@ -14,6 +14,11 @@ var (
 	lastFetched   time.Time
 	cacheMutex    sync.Mutex
 	cacheDuration = 5 * time.Minute
+
+	// Refreshes from the palette are throttled for everyone, so visitors
+	// can't keep MongoDB busy
+	refreshInterval = 30 * time.Second
+	refreshing      bool
 )
 
 // GetOrFetchData returns cached data if fresh, or fetches new data if expired
@@ -40,6 +45,31 @@ func GetOrFetchData() config.AllMessages {
 	return globalCache
 }
 
+// RefreshData fetches fresh data, unless it was fetched less than
+// refreshInterval ago or another session is already fetching it. The
+// fetch runs outside the lock, so other sessions keep getting the cache.
+func RefreshData() config.AllMessages {
+	cacheMutex.Lock()
+	if refreshing || (!lastFetched.IsZero() && time.Since(lastFetched) < refreshInterval) {
+		defer cacheMutex.Unlock()
+		return globalCache
+	}
+	refreshing = true
+	cacheMutex.Unlock()
+
+	log.Println("🔄 Refresh requested. Fetching fresh data from MongoDB...")
+	newData := fetchAllDataSync()
+
+	cacheMutex.Lock()
+	defer cacheMutex.Unlock()
+	refreshing = false
+	if len(newData) > 0 {
+		globalCache = newData
+		lastFetched = time.Now()
+	}
+	return globalCache
+}
+
 // fetchAllDataSync gets data synchronously (blocking) for the initial load
 func fetchAllDataSync() config.AllMessages {
 	var alldata config.AllMessages
@@ -0,0 +1,82 @@
+package tui
+
+import (
+	"fmt"
+	"portfolioTUI/config"
+	"portfolioTUI/utils"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// How long toasts stay in the footer
+var (
+	toastDuration         = 3 * time.Second
+	fallbackToastDuration = 10 * time.Second // Longer so the raw text can be selected by hand
+)
+
+// yankTarget picks what "y" copies: the selected item's primary link,
+// or the owner's email when nothing is selected
+func (m Model) yankTarget() (label, value string) {
+	// Projects tab (or a project card on Home): its live demo, else its source
+	project := -1
+	if m.ActiveTab == 1 {
+		project = m.ProjectCursor
+	} else if m.ActiveTab == 0 && m.HomeFocus.Kind == "projects" {
+		project = m.HomeFocus.Index
+	}
+	if project >= 0 && project < len(m.Projects) {
+		p := m.Projects[project]
+		if live := utils.SafeString(p, "liveUrl"); live != "" {
+			return "link", live
+		}
+		if github := utils.SafeString(p, "githubUrl"); github != "" {
+			return "link", github
+		}
+	}
+
+	// Blogs tab (or an article card on Home): the article's page
+	blog := -1
+	if m.ActiveTab == 4 {
+		blog = m.BlogCursor
+	} else if m.ActiveTab == 0 && m.HomeFocus.Kind == "blogs" {
+		blog = m.HomeFocus.Index
+	}
+	if blog >= 0 && blog < len(m.Blogs) {
+		return "link", blogLink(m.Blogs[blog])
+	}
+	return "email", config.OwnerEmail
+}
+
+// yank copies the current target to the visitor's clipboard via OSC 52.
+// Terminals without OSC 52 get the raw text in the toast instead.
+func (m *Model) yank() tea.Cmd {
+	return m.copyToClipboard(m.yankTarget())
+}
+
+// copyToClipboard copies any text, announcing it in a toast
+func (m *Model) copyToClipboard(label, value string) tea.Cmd {
+	if !m.ClipboardSupported {
+		m.PendingCopy = ""
+		// (With the mouse on, terminals select text on shift+drag)
+		how := "select"
+		if m.MouseEnabled {
+			how = "shift+drag"
+		}
+		return m.showToast(fmt.Sprintf("Clipboard not supported, %s to copy %s: %s", how, label, value), fallbackToastDuration)
+	}
+
+	// The sequence is written out with the toast in View
+	m.PendingCopy = utils.CopySequence(value, m.Term)
+	return m.showToast(fmt.Sprintf("✓ Copied %s: %s", label, value), toastDuration)
+}
+
+// showToast displays a short message in the footer until the timer expires
+func (m *Model) showToast(text string, d time.Duration) tea.Cmd {
+	m.ToastID++
+	m.Toast = text
+	id := m.ToastID
+	return tea.Tick(d, func(time.Time) tea.Msg {
+		return config.ToastExpiredMsg{ID: id}
+	})
+}
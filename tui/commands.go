@@ -0,0 +1,259 @@
+package tui
+
+import (
+	"portfolioTUI/config"
+	"portfolioTUI/utils"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/sahilm/fuzzy"
+)
+
+// paletteCommand is one entry of the command palette (ctrl+k)
+type paletteCommand struct {
+	Title    string
+	Category string      // "Go to", "Project", "Blog", "Action"
+	Key      key.Binding // Hotkey outside the palette, if any
+	Run      func(m *Model) tea.Cmd
+}
+
+// commandSources build the palette's entries from the session state.
+// Features add theirs with registerCommands (from init), so nothing needs
+// to touch the palette itself.
+var commandSources []func(m Model) []paletteCommand
+
+func registerCommands(source func(m Model) []paletteCommand) {
+	commandSources = append(commandSources, source)
+}
+
+func init() {
+	registerCommands(navigationCommands)
+	registerCommands(actionCommands)
+	registerCommands(projectCommands)
+	registerCommands(blogCommands)
+}
+
+// navigationCommands switch tabs (H/P/E/S/B/C)
+func navigationCommands(m Model) []paletteCommand {
+	k := m.Keys.Global
+	tabs := []struct {
+		Name string
+		Key  key.Binding
+	}{
+		{"Home", k.Home}, {"Projects", k.Projects}, {"Experience", k.Experience},
+		{"Services", k.Services}, {"Blogs", k.Blogs}, {"Contact", k.Contact},
+	}
+	var cmds []paletteCommand
+	for i, t := range tabs {
+		tab := i
+		cmds = append(cmds, paletteCommand{
+			Title:    t.Name,
+			Category: "Go to",
+			Key:      t.Key,
+			Run: func(m *Model) tea.Cmd {
+				m.goToTab(tab)
+				return nil
+			},
+		})
+	}
+	return cmds
+}
+
+// actionCommands are everything that isn't navigation
+func actionCommands(m Model) []paletteCommand {
+	k := m.Keys.Global
+	return []paletteCommand{
+		{Title: "Search everything", Category: "Action", Key: k.Search, Run: (*Model).openSearch},
+		{Title: "Copy link / email of the selection", Category: "Action", Key: k.Yank, Run: (*Model).yank},
+		{Title: "Copy email address", Category: "Action", Run: func(m *Model) tea.Cmd {
+			return m.copyToClipboard("email", config.OwnerEmail)
+		}},
+		{Title: "Download resume (copy link)", Category: "Action", Run: func(m *Model) tea.Cmd {
+			return m.copyToClipboard("resume link", config.ResumeURL)
+		}},
+		{Title: "Switch image style", Category: "Action", Key: k.Images, Run: (*Model).cycleImageMode},
+		{Title: "Switch theme", Category: "Action", Key: k.Theme, Run: (*Model).cycleTheme},
+		{Title: "Toggle mouse", Category: "Action", Key: k.Mouse, Run: (*Model).toggleMouse},
+		{Title: "Show keyboard shortcuts", Category: "Action", Key: k.Help, Run: func(m *Model) tea.Cmd {
+			m.HelpOpen = true
+			return nil
+		}},
+		{Title: "Refresh data", Category: "Action", Run: func(m *Model) tea.Cmd {
+			return tea.Batch(m.showToast("Refreshing data...", toastDuration), func() tea.Msg {
+				return RefreshData()
+			})
+		}},
+	}
+}
+
+// projectCommands open each project's page
+func projectCommands(m Model) []paletteCommand {
+	var cmds []paletteCommand
+	for i, p := range m.Projects {
+		index := i
+		cmds = append(cmds, paletteCommand{
+			Title:    utils.SafeString(p, "title"),
+			Category: "Project",
+			Run:      func(m *Model) tea.Cmd { return m.openProject(index) },
+		})
+	}
+	return cmds
+}
+
+// blogCommands open each article in the reader
+func blogCommands(m Model) []paletteCommand {
+	var cmds []paletteCommand
+	for i, b := range m.Blogs {
+		index := i
+		cmds = append(cmds, paletteCommand{
+			Title:    utils.SafeString(b, "title"),
+			Category: "Blog",
+			Run: func(m *Model) tea.Cmd {
+				m.openBlog(index)
+				return nil
+			},
+		})
+	}
+	return cmds
+}
+
+// commands lists every registered command
+func (m Model) commands() []paletteCommand {
+	var cmds []paletteCommand
+	for _, source := range commandSources {
+		cmds = append(cmds, source(m)...)
+	}
+	return cmds
+}
+
+// runHotkey runs the command bound to a key, if any
+func (m *Model) runHotkey(msg tea.KeyMsg) (tea.Cmd, bool) {
+	for _, c := range m.commands() {
+		if key.Matches(msg, c.Key) {
+			return c.Run(m), true
+		}
+	}
+	return nil, false
+}
+
+// paletteSource lets fuzzy match "Category Title"
+type paletteSource []paletteCommand
+
+func (s paletteSource) String(i int) string { return s[i].Category + " " + s[i].Title }
+func (s paletteSource) Len() int            { return len(s) }
+
+// filterPalette keeps the commands matching the query, best first
+func (m *Model) filterPalette() {
+	all := m.commands()
+	m.PaletteCursor = 0
+
+	query := strings.TrimSpace(m.Palette.Value())
+	if query == "" {
+		m.PaletteItems = all
+		return
+	}
+	m.PaletteItems = nil
+	for _, match := range fuzzy.FindFrom(query, paletteSource(all)) {
+		m.PaletteItems = append(m.PaletteItems, all[match.Index])
+	}
+}
+
+// openPalette shows the command palette
+func (m *Model) openPalette() tea.Cmd {
+	ti := textinput.New()
+	ti.Prompt = "> "
+	ti.Placeholder = "Type a command, project or article..."
+	ti.PlaceholderStyle = m.Styles.Placeholder
+	ti.PromptStyle = m.Styles.Highlight
+	ti.TextStyle = m.Styles.Cursor
+	ti.Cursor.Style = m.Styles.Cursor
+	ti.Cursor.TextStyle = m.Styles.Cursor
+	ti.CharLimit = 64
+
+	m.Palette = ti
+	m.PaletteOpen = true
+	m.filterPalette()
+	return m.Palette.Focus()
+}
+
+// updatePalette handles keys while the palette is open
+func (m *Model) updatePalette(msg tea.KeyMsg) tea.Cmd {
+	switch k := m.Keys.List; {
+	case key.Matches(msg, k.Close, m.Keys.Global.Palette):
+		m.PaletteOpen = false
+		return nil
+	case key.Matches(msg, k.Select):
+		if m.PaletteCursor >= len(m.PaletteItems) {
+			return nil
+		}
+		m.PaletteOpen = false
+		var cmds []tea.Cmd
+		if m.GalleryOpen {
+			cmds = append(cmds, m.closeGallery())
+		}
+		return tea.Batch(append(cmds, m.PaletteItems[m.PaletteCursor].Run(m))...)
+	case key.Matches(msg, k.Up):
+		if n := len(m.PaletteItems); n > 0 {
+			m.PaletteCursor = (m.PaletteCursor - 1 + n) % n
+		}
+		return nil
+	case key.Matches(msg, k.Down):
+		if n := len(m.PaletteItems); n > 0 {
+			m.PaletteCursor = (m.PaletteCursor + 1) % n
+		}
+		return nil
+	}
+
+	before := m.Palette.Value()
+	var cmd tea.Cmd
+	m.Palette, cmd = m.Palette.Update(msg)
+	if m.Palette.Value() != before {
+		m.filterPalette()
+	}
+	return cmd
+}
+
+// renderPalette draws the palette over the whole screen
+func (m Model) renderPalette() string {
+	width := min(m.Width-4, 70)
+	palette := m.Palette
+	palette.Width = max(width-8, 10)
+
+	categoryStyle := m.Styles.Subtle.Width(10)
+	keyStyle := m.Styles.Subtle
+	var lines []string
+	for i, c := range m.PaletteItems {
+		cursor, titleStyle := "  ", m.Renderer.NewStyle().Foreground(m.Theme.Text)
+		if i == m.PaletteCursor {
+			cursor, titleStyle = m.Styles.Highlight.Render("▸ "), m.Styles.Highlight
+		}
+		hotkey := ""
+		if c.Key.Enabled() {
+			hotkey = keyStyle.Render("  " + c.Key.Help().Key)
+		}
+		title := truncate(c.Title, max(width-16-lipgloss.Width(hotkey), 10))
+		lines = append(lines, cursor+categoryStyle.Render(c.Category)+titleStyle.Render(title)+hotkey)
+	}
+	if len(lines) == 0 {
+		lines = append(lines, m.Styles.Subtle.Render("No matching commands"))
+	}
+
+	// Keep the selection on screen
+	if avail := max(m.Height-10, 3); len(lines) > avail {
+		start := min(max(m.PaletteCursor-avail/2, 0), len(lines)-avail)
+		lines = lines[start : start+avail]
+	}
+
+	box := m.Styles.FocusedBorder.Width(width).Render(lipgloss.JoinVertical(lipgloss.Left,
+		palette.View(),
+		"",
+		strings.Join(lines, "\n"),
+	))
+	help := m.Styles.GalleryHelp.Render(m.Help.ShortHelpView([]key.Binding{m.Keys.List.Up, m.Keys.List.Down, m.Keys.List.Select, m.Keys.List.Close}))
+
+	return m.Renderer.Place(m.Width, m.Height, lipgloss.Center, lipgloss.Center,
+		lipgloss.JoinVertical(lipgloss.Center, box, "", help))
+}
@@ -2,142 +2,379 @@ package tui
 
 import (
 	"fmt"
+	"portfolioTUI/config"
 	"portfolioTUI/utils"
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
-)
-
-// Styles matching your web UI
-var (
-	focusedBorder = lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).BorderForeground(lipgloss.Color("63")).Padding(0, 1)
-	blurredBorder = lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).BorderForeground(lipgloss.Color("240")).Padding(0, 1)
-	labelStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("245"))
-	titleStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("63")).Bold(true).Align(lipgloss.Center)
-	subTitleStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("240")).Align(lipgloss.Center)
-	btnStyle      = lipgloss.NewStyle().Foreground(lipgloss.Color("255")).Background(lipgloss.Color("63")).Padding(0, 3).Bold(true)
+	"go.mongodb.org/mongo-driver/v2/bson"
 )
 
 func (m Model) renderContactSection(width int) string {
+	content, _ := m.layoutContact(width)
+	return content
+}
+
+// layoutContact renders the Contact tab, and where each form field (and
+// the submit button) is
+func (m Model) layoutContact(width int) (string, []cardRect) {
 	// If form was submitted successfully, show a Thank You message
 	if m.FormSuccess {
-		return lipgloss.Place(width, m.Viewport.Height, lipgloss.Center, lipgloss.Center,
-			lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).Padding(2).Render(
+		return m.Renderer.Place(width, m.Viewport.Height, lipgloss.Center, lipgloss.Center,
+			m.Renderer.NewStyle().Border(lipgloss.RoundedBorder()).Padding(2).Render(
 				lipgloss.JoinVertical(lipgloss.Center,
-					titleStyle.Render("Message Sent! 🚀"),
-					subTitleStyle.Render("\nThank you for reaching out."),
-					lipgloss.NewStyle().Foreground(lipgloss.Color("240")).Render("\n(Press 'Enter' to send another)"),
+					m.Styles.Title.Render("Message Sent! 🚀"),
+					m.Styles.SubTitle.Render("\nThank you for reaching out."),
+					m.Renderer.NewStyle().Foreground(m.Theme.Muted).Render("\n(Press 'Enter' to send another)"),
 				),
 			),
-		)
+		), nil
+	}
+
+	// Show the summary screen before anything is sent
+	if m.ContactReview {
+		return m.renderContactReview(width), nil
 	}
 
 	doc := strings.Builder{}
+	var rects []cardRect
 
 	// --- 1. DYNAMIC WIDTH CALCULATION ---
 	// Subtract 6 for padding/borders to ensure no overflow
 	fullWidth := width - 6
-	if fullWidth < 40 {
-		fullWidth = 40
+	if fullWidth < 30 {
+		fullWidth = 30
 	} // Safety minimum
 
-	// Split width for First/Last name
+	// Split width for half-width fields (First/Last name)
 	halfWidth := (fullWidth - 2) / 2
 
-	// Resize Inputs
-	m.FirstNameInput.Width = halfWidth - 3
-	m.LastNameInput.Width = halfWidth - 3
-	m.EmailInput.Width = fullWidth - 3
-	m.MsgInput.SetWidth(fullWidth - 3)
-
 	// --- 2. HEADER ---
 	header := lipgloss.JoinVertical(lipgloss.Center,
-		titleStyle.Width(width).Render("Get In Touch"),
-		subTitleStyle.Width(width).Render("Have a question or want to work together?"),
+		m.Styles.Title.Width(width).Render("Get In Touch"),
+		m.Styles.SubTitle.Width(width).Render("Have a question or want to work together?"),
 	)
 	doc.WriteString(header + "\n\n")
 
-	// Helper for border styles
-	getStyle := func(i int) lipgloss.Style {
-		if m.FocusIndex == i {
-			return focusedBorder
+	// --- 3. FIELDS (Rendered from the form schema) ---
+	// Half-width fields are paired up on one row (unless the screen is narrow)
+	for i := 0; i < len(m.Form); i++ {
+		in := m.Form[i]
+		top := lineCount(doc.String())
+		if in.Field.Half && i+1 < len(m.Form) && m.Form[i+1].Field.Half && !stacked(width) {
+			left := m.renderFormField(i, in, halfWidth)
+			right := m.renderFormField(i+1, m.Form[i+1], halfWidth)
+			rects = append(rects,
+				cardRect{Kind: "field", Index: i, Y: top, W: lipgloss.Width(left), H: lipgloss.Height(left)},
+				cardRect{Kind: "field", Index: i + 1, X: lipgloss.Width(left) + 2, Y: top, W: lipgloss.Width(right), H: lipgloss.Height(right)},
+			)
+			doc.WriteString(lipgloss.JoinHorizontal(lipgloss.Top, left, "  ", right) + "\n\n")
+			i++
+			continue
 		}
-		return blurredBorder
+		field := m.renderFormField(i, in, fullWidth)
+		rects = append(rects, cardRect{Kind: "field", Index: i, Y: top, W: lipgloss.Width(field), H: lipgloss.Height(field)})
+		doc.WriteString(field + "\n\n")
 	}
 
-	// --- 3. NAME ROW ---
-	fName := lipgloss.JoinVertical(lipgloss.Left,
-		labelStyle.Render("First Name *"),
-		getStyle(0).Width(halfWidth).Render(m.FirstNameInput.View()),
-	)
-	lName := lipgloss.JoinVertical(lipgloss.Left,
-		labelStyle.Render("Last Name *"),
-		getStyle(1).Width(halfWidth).Render(m.LastNameInput.View()),
-	)
-	doc.WriteString(lipgloss.JoinHorizontal(lipgloss.Top, fName, "  ", lName) + "\n\n")
-
-	// --- 4. EMAIL ---
-	email := lipgloss.JoinVertical(lipgloss.Left,
-		labelStyle.Render("Email *"),
-		getStyle(2).Width(fullWidth).Render(m.EmailInput.View()),
-	)
-	doc.WriteString(email + "\n\n")
+	// --- 8. SUBMIT BUTTON / LOADING ---
+	var btnRender string
 
-	// --- 5. USER TYPE ---
-	profIcon, studIcon := "( )", "( )"
-	if m.UserType == "Professional" {
-		profIcon = "(*)"
+	if m.ContactLoading {
+		// SHOW LOADING SPINNER
+		btnRender = lipgloss.JoinHorizontal(lipgloss.Center, m.Spinner.View(), " Sending...")
 	} else {
-		studIcon = "(*)"
+		// SHOW BUTTON
+		btnRender = m.Styles.Button.Render("Submit Message ->")
+		if m.FocusIndex == m.submitIndex() {
+			btnRender = m.Renderer.NewStyle().Border(lipgloss.ThickBorder()).BorderForeground(m.Theme.Border).Render(btnRender)
+		}
 	}
 
-	radioStyle := lipgloss.NewStyle().Padding(1, 0)
-	if m.FocusIndex == 3 {
-		radioStyle = radioStyle.Foreground(lipgloss.Color("63")).Bold(true)
-	}
+	rects = append(rects, cardRect{Kind: "submit", Index: m.submitIndex(), X: centerOffset(width, lipgloss.Width(btnRender)),
+		Y: lineCount(doc.String()), W: lipgloss.Width(btnRender), H: lipgloss.Height(btnRender)})
+	doc.WriteString(m.Renderer.PlaceHorizontal(width, lipgloss.Center, btnRender) + "\n\n")
+	return doc.String(), rects
+}
 
-	doc.WriteString(labelStyle.Render("You are a") + "\n")
-	doc.WriteString(radioStyle.Render(fmt.Sprintf("%s Professional    %s Student", profIcon, studIcon)) + "\n\n")
+// renderContactReview shows the filled-in form and the exact document to be stored
+func (m Model) renderContactReview(width int) string {
+	doc := strings.Builder{}
 
-	// --- 6. SERVICES ---
-	svcTitle, svcPrice := "-- Select a service --", ""
-	if len(m.Services) > 0 && m.SelectedService < len(m.Services) {
-		s := m.Services[m.SelectedService]
-		svcTitle = utils.SafeString(s, "title")
-		svcPrice = utils.SafeString(s, "price")
+	fullWidth := width - 6
+	if fullWidth < 40 {
+		fullWidth = 40
 	}
+	valueWidth := fullWidth - 18
 
-	// Truncate title
-	if len(svcTitle) > fullWidth-20 {
-		svcTitle = svcTitle[:fullWidth-20] + "..."
+	// --- 1. HEADER ---
+	header := lipgloss.JoinVertical(lipgloss.Center,
+		m.Styles.Title.Width(width).Render("Review Your Message"),
+		m.Styles.SubTitle.Width(width).Render("Check everything before it's sent"),
+	)
+	doc.WriteString(header + "\n\n")
+
+	// --- 2. SUMMARY ROWS ---
+	row := func(label, value string) string {
+		return lipgloss.JoinHorizontal(lipgloss.Top,
+			m.Styles.Label.Width(16).Render(label),
+			m.Renderer.NewStyle().Width(valueWidth).Render(value),
+		)
 	}
-	svcContent := fmt.Sprintf("◄  %-*s  %10s  ►", fullWidth-20, svcTitle, svcPrice)
 
-	doc.WriteString(lipgloss.JoinVertical(lipgloss.Left,
-		labelStyle.Render("Select a Service (Optional)"),
-		getStyle(4).Width(fullWidth).Render(svcContent),
-	) + "\n\n")
+	var rows []string
+	for _, in := range m.Form {
+		rows = append(rows, row(fieldLabel(in.Field), m.displayValue(in)))
+	}
+	summary := lipgloss.JoinVertical(lipgloss.Left, rows...)
+	doc.WriteString(m.Styles.FocusedBorder.Width(fullWidth).Render(summary) + "\n\n")
 
-	// --- 7. MESSAGE ---
+	// --- 3. STORED DOCUMENT (Transparency) ---
+	stored, err := bson.MarshalExtJSONIndent(m.PendingContact, false, false, "", "  ")
+	storedStr := string(stored)
+	if err != nil {
+		storedStr = "Could not preview document: " + err.Error()
+	}
 	doc.WriteString(lipgloss.JoinVertical(lipgloss.Left,
-		labelStyle.Render("Message *"),
-		getStyle(5).Width(fullWidth).Render(m.MsgInput.View()),
+		m.Styles.Label.Render("Data that will be stored (_id and createdAt are set on save)"),
+		m.Styles.BlurredBorder.Width(fullWidth).Render(m.Styles.Subtle.Render(storedStr)),
 	) + "\n\n")
 
-	// --- 8. SUBMIT BUTTON / LOADING ---
-	var btnRender string
-
+	// --- 4. ACTIONS / LOADING ---
+	var actions string
 	if m.ContactLoading {
-		// SHOW LOADING SPINNER
-		btnRender = lipgloss.JoinHorizontal(lipgloss.Center, m.Spinner.View(), " Sending...")
+		actions = lipgloss.JoinHorizontal(lipgloss.Center, m.Spinner.View(), " Sending...")
 	} else {
-		// SHOW BUTTON
-		btnRender = btnStyle.Render("Submit Message ->")
-		if m.FocusIndex == 6 {
-			btnRender = lipgloss.NewStyle().Border(lipgloss.ThickBorder()).BorderForeground(lipgloss.Color("63")).Render(btnRender)
+		actions = lipgloss.JoinHorizontal(lipgloss.Center,
+			m.Styles.Button.Render("Enter: Confirm & Send"),
+			"   ",
+			m.Styles.BlurredBorder.Render("E / Esc: Edit"),
+		)
+		if m.ContactFailed {
+			failStyle := m.Renderer.NewStyle().Foreground(m.Theme.Error).Bold(true)
+			actions = lipgloss.JoinVertical(lipgloss.Center,
+				failStyle.Render("Sending failed. Press Enter to try again."),
+				"",
+				actions,
+			)
 		}
 	}
+	doc.WriteString(m.Renderer.PlaceHorizontal(width, lipgloss.Center, actions) + "\n\n")
 
-	doc.WriteString(lipgloss.PlaceHorizontal(width, lipgloss.Center, btnRender) + "\n\n")
 	return doc.String()
 }
+
+// renderFormField draws one schema field (label, input and validation error)
+func (m Model) renderFormField(index int, in formInput, width int) string {
+	style := m.Styles.BlurredBorder
+	if m.FocusIndex == index {
+		style = m.Styles.FocusedBorder
+	}
+
+	label := in.Field.Label
+	if in.Field.Required {
+		label += " *"
+	}
+
+	var body string
+	switch in.Field.Type {
+	case config.FieldText, config.FieldEmail:
+		in.Text.Width = width - 3
+		body = in.Text.View()
+
+	case config.FieldTextarea:
+		in.Area.SetWidth(width - 3)
+		body = in.Area.View()
+
+	case config.FieldRadio:
+		// ( ) Professional    (*) Student
+		var opts []string
+		for j, opt := range m.fieldOptions(in.Field) {
+			icon := "( )"
+			if j == in.Choice {
+				icon = "(*)"
+			}
+			opts = append(opts, fmt.Sprintf("%s %s", icon, opt.Label))
+		}
+		radioStyle := m.Renderer.NewStyle().Padding(1, 0)
+		if m.FocusIndex == index {
+			radioStyle = radioStyle.Foreground(m.Theme.Primary).Bold(true)
+		}
+		return m.withFieldError(in, lipgloss.JoinVertical(lipgloss.Left,
+			m.Styles.Label.Width(width).Render(label),
+			radioStyle.Width(width).Render(strings.Join(opts, "    ")),
+		))
+
+	case config.FieldSelect:
+		choice := "-- Select --"
+		if opts := m.fieldOptions(in.Field); in.Choice < len(opts) {
+			choice = opts[in.Choice].Label
+		}
+		body = fmt.Sprintf("◄  %-*s  ►", width-12, choice)
+
+	case config.FieldCheckbox:
+		body = m.renderChecklist(index, in, width)
+	}
+
+	return m.withFieldError(in, lipgloss.JoinVertical(lipgloss.Left,
+		m.Styles.Label.Width(width).Render(label),
+		style.Width(width).Render(body),
+	))
+}
+
+// renderChecklist draws a checkbox field, plus the estimate for services
+func (m Model) renderChecklist(index int, in formInput, width int) string {
+	var rows []string
+	for j, opt := range m.fieldOptions(in.Field) {
+		box := "[ ]"
+		if in.isChecked(opt.Value) {
+			box = "[x]"
+		}
+
+		cursor := "  "
+		rowStyle := m.Renderer.NewStyle()
+		if m.FocusIndex == index && j == in.Cursor {
+			cursor = "▸ "
+			rowStyle = rowStyle.Foreground(m.Theme.Primary).Bold(true)
+		}
+
+		// Truncate label so the meta column stays aligned
+		labelWidth := width - lipgloss.Width(opt.Meta) - 12
+		if labelWidth < 10 {
+			labelWidth = 10
+		}
+		optLabel := truncate(opt.Label, labelWidth)
+
+		rows = append(rows, rowStyle.Render(fmt.Sprintf("%s%s %-*s  %s", cursor, box, labelWidth, optLabel, opt.Meta)))
+	}
+	if len(rows) == 0 {
+		rows = append(rows, m.Styles.Subtle.Render("Nothing to choose from right now"))
+	}
+
+	// Running estimate for everything that's checked
+	if in.Field.OptionsFrom == "services" {
+		rows = append(rows, "", m.serviceEstimate(in.Checked))
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, rows...)
+}
+
+// withFieldError appends the validation message under a field (if any)
+func (m Model) withFieldError(in formInput, field string) string {
+	if msg, ok := m.FormErrors[in.Field.Name]; ok {
+		return lipgloss.JoinVertical(lipgloss.Left, field,
+			m.Renderer.NewStyle().Foreground(m.Theme.Error).Render("✗ "+msg),
+		)
+	}
+	return field
+}
+
+// fieldLabel trims hints like "(Optional • Space to toggle)" for the summary
+func fieldLabel(f config.FormField) string {
+	if i := strings.Index(f.Label, " ("); i > 0 {
+		return f.Label[:i]
+	}
+	return f.Label
+}
+
+// displayValue renders a field value for the review screen
+func (m Model) displayValue(in formInput) string {
+	switch val := m.fieldValue(in).(type) {
+	case string:
+		// Show option labels rather than stored values
+		for _, opt := range m.fieldOptions(in.Field) {
+			if opt.Value == val {
+				return opt.Label
+			}
+		}
+		if val == "" {
+			return m.Styles.Subtle.Render("(empty)")
+		}
+		return val
+
+	case []string:
+		if len(val) == 0 {
+			return m.Styles.Subtle.Render("None selected")
+		}
+		var lines []string
+		for _, opt := range m.fieldOptions(in.Field) {
+			if in.isChecked(opt.Value) {
+				lines = append(lines, "• "+opt.Label)
+			}
+		}
+		if in.Field.OptionsFrom == "services" {
+			lines = append(lines, m.serviceEstimate(val))
+		}
+		return strings.Join(lines, "\n")
+	}
+	return ""
+}
+
+// serviceIndex returns the position of a service ID in m.Services, or -1
+func (m Model) serviceIndex(id string) int {
+	for i, s := range m.Services {
+		if utils.SafeID(s, "_id") == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// serviceEstimate sums price and timeframe of the given services
+func (m Model) serviceEstimate(serviceIDs []string) string {
+	if len(serviceIDs) == 0 {
+		return m.Styles.Subtle.Render("Estimate: pick one or more services")
+	}
+
+	var (
+		totals            = map[string]float64{} // By currency, "$100" and "₹5000" don't add up
+		currencies        []string               // In the order they were picked
+		minDays, maxDays  int
+		hasPrice, hasTime bool
+		custom            bool // Some service has no parsable price/timeframe
+	)
+
+	for _, id := range serviceIDs {
+		i := m.serviceIndex(id)
+		if i < 0 {
+			continue
+		}
+		s := m.Services[i]
+
+		if amount, cur, ok := utils.ParsePrice(utils.SafeString(s, "price")); ok {
+			if _, seen := totals[cur]; !seen {
+				currencies = append(currencies, cur)
+			}
+			totals[cur] += amount
+			hasPrice = true
+		} else {
+			custom = true
+		}
+
+		if lo, hi, ok := utils.ParseTimeframe(utils.SafeString(s, "timeframe")); ok {
+			minDays += lo
+			maxDays += hi
+			hasTime = true
+		} else {
+			custom = true
+		}
+	}
+
+	priceStr, timeStr := "custom quote", "to be discussed"
+	if hasPrice {
+		var parts []string
+		for _, cur := range currencies {
+			parts = append(parts, utils.FormatPrice(totals[cur], cur))
+		}
+		priceStr = strings.Join(parts, " + ")
+	}
+	if hasTime {
+		timeStr = utils.FormatDays(minDays, maxDays)
+	}
+
+	estimate := fmt.Sprintf("Estimate (%d selected): %s • %s", len(serviceIDs), priceStr, timeStr)
+	if custom && (hasPrice || hasTime) {
+		estimate += " + custom quote"
+	}
+	return m.Styles.Highlight.Render(estimate)
+}
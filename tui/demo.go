@@ -0,0 +1,105 @@
+package tui
+
+import (
+	"portfolioTUI/config"
+	"portfolioTUI/utils"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// demoImage returns the animation on screen, if any: the gallery's current
+// slide, or the demo GIF on a project's detail page
+func (m Model) demoImage() (galleryImage, bool) {
+	if m.GalleryOpen {
+		images := m.galleryImages()
+		if m.GalleryIndex >= len(images) || !images[m.GalleryIndex].Animated {
+			return galleryImage{}, false
+		}
+		return images[m.GalleryIndex], true
+	}
+
+	if m.ProjectDetail && m.ActiveTab == 1 && m.ProjectCursor < len(m.Projects) {
+		if demo := utils.SafeString(m.Projects[m.ProjectCursor], "demoGif"); demo != "" {
+			return galleryImage{URL: demo, Caption: "Demo", Animated: true}, true
+		}
+	}
+	return galleryImage{}, false
+}
+
+// demoOptions is how the animation on screen is rendered at the current size
+func (m Model) demoOptions(url string) utils.ImageOptions {
+	opts := m.ImageRender
+	opts.Source = url
+	if m.GalleryOpen {
+		opts.Width, opts.Height = m.gallerySize()
+	} else {
+		opts.Width, opts.Height = detailImageSize(m.Viewport.Width)
+	}
+	return utils.AnimationOptions(opts)
+}
+
+// isCurrentDemo reports whether frames belong to the animated slide on
+// screen, at its current size and style
+func (m Model) isCurrentDemo(anim utils.AnimationMsg) bool {
+	img, ok := m.demoImage()
+	if !ok {
+		return false
+	}
+	opts := m.demoOptions(img.URL)
+	return anim.Source == opts.Source && anim.Mode == opts.Mode &&
+		anim.Width == opts.Width && anim.Height == opts.Height
+}
+
+// loadDemo fetches the frames of the animated slide on screen
+// (nothing to do when they are already loaded)
+func (m Model) loadDemo() tea.Cmd {
+	img, ok := m.demoImage()
+	if !ok || (m.isCurrentDemo(m.Demo) && len(m.Demo.Frames) > 0) {
+		return nil
+	}
+	return utils.GenerateAnimation(m.demoOptions(img.URL), utils.PriorityVisible)
+}
+
+// playDemo starts playback from the current frame. Any tick still in
+// flight belongs to an older Seq and is dropped.
+func (m *Model) playDemo() tea.Cmd {
+	m.DemoSeq++
+	if m.DemoPaused || len(m.Demo.Frames) < 2 {
+		return nil
+	}
+	return demoTick(m.DemoSeq, m.Demo.Delays[m.DemoFrame])
+}
+
+// stopDemo forgets the playing GIF (its slide is no longer on screen)
+func (m *Model) stopDemo() {
+	m.DemoSeq++
+	m.Demo = utils.AnimationMsg{}
+	m.DemoFrame = 0
+}
+
+// toggleDemo pauses or resumes playback
+func (m *Model) toggleDemo() tea.Cmd {
+	if _, ok := m.demoImage(); !ok {
+		return nil
+	}
+	m.DemoPaused = !m.DemoPaused
+	return m.playDemo()
+}
+
+// nextDemoFrame advances playback when a tick arrives. Playback stops by
+// itself once the GIF is paused or off screen: no more ticks are scheduled.
+func (m *Model) nextDemoFrame(msg config.DemoTickMsg) tea.Cmd {
+	if msg.Seq != m.DemoSeq || m.DemoPaused || !m.isCurrentDemo(m.Demo) || len(m.Demo.Frames) < 2 {
+		return nil
+	}
+	m.DemoFrame = (m.DemoFrame + 1) % len(m.Demo.Frames)
+	return demoTick(m.DemoSeq, m.Demo.Delays[m.DemoFrame])
+}
+
+// demoTick schedules the next frame
+func demoTick(seq int, delay time.Duration) tea.Cmd {
+	return tea.Tick(delay, func(time.Time) tea.Msg {
+		return config.DemoTickMsg{Seq: seq}
+	})
+}
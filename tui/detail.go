@@ -0,0 +1,154 @@
+package tui
+
+import (
+	"portfolioTUI/config"
+	"portfolioTUI/utils"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// detailImageSize is the size of the big picture on the project page
+func detailImageSize(width int) (w, h int) {
+	w = min(max(width-8, 16), 64)
+	return w, w * 15 / 32
+}
+
+// openProjectDetail shows the selected project on its own page,
+// remembering where the list was scrolled to
+func (m *Model) openProjectDetail() tea.Cmd {
+	if m.ProjectCursor >= len(m.Projects) || !m.projectMatchesTags(m.Projects[m.ProjectCursor]) {
+		return nil
+	}
+	m.ProjectDetail = true
+	m.ProjectScroll = m.Viewport.YOffset
+	m.clearArt("detail")
+	m.stopDemo()
+
+	m.Viewport.SetContent(m.renderProjectDetail(m.Viewport.Width))
+	m.Viewport.GotoTop()
+
+	return tea.Batch(append(m.generateDetailImages(), m.loadDemo())...)
+}
+
+// openProject jumps to a project's page from anywhere. The list behind is
+// scrolled to it for when they go back.
+func (m *Model) openProject(index int) tea.Cmd {
+	if index >= len(m.Projects) {
+		return nil
+	}
+	m.goToTab(1)
+	// A project hidden by the tag filter brings every project back
+	if !m.projectMatchesTags(m.Projects[index]) {
+		clear(m.TagFilter)
+	}
+	m.selectCard("projects", index)
+	return m.openProjectDetail()
+}
+
+// closeProjectDetail goes back to the list, where the visitor left it
+func (m *Model) closeProjectDetail() {
+	m.ProjectDetail = false
+	m.clearArt("detail")
+	m.stopDemo()
+
+	m.Viewport.SetContent(m.renderProject(m.Viewport.Width))
+	m.Viewport.SetYOffset(m.ProjectScroll)
+}
+
+// generateDetailImages renders the big picture of the open project
+func (m Model) generateDetailImages() []tea.Cmd {
+	if !m.ProjectDetail || m.ProjectCursor >= len(m.Projects) || m.Viewport.Width == 0 {
+		return nil
+	}
+
+	opts := m.ImageRender
+	opts.Source = detailImageURL(m.Projects[m.ProjectCursor])
+	opts.Width, opts.Height = detailImageSize(m.Viewport.Width)
+	return []tea.Cmd{utils.GenerateAsciiImage("detail", m.ProjectCursor, opts, utils.PriorityVisible)}
+}
+
+// detailImageURL is the project's picture, or the default one
+func detailImageURL(p bson.M) string {
+	if url := utils.SafeString(p, "imageUrl"); len(url) >= 5 {
+		return url
+	}
+	return config.DEFAULTIMAGEURL
+}
+
+// renderProjectDetail draws the full page of the selected project
+func (m Model) renderProjectDetail(width int) string {
+	if m.ProjectCursor >= len(m.Projects) {
+		return ""
+	}
+	p := m.Projects[m.ProjectCursor]
+	contentWidth := max(width-4, 20)
+
+	doc := strings.Builder{}
+	doc.WriteString(m.Styles.Subtle.Render("← "+m.Keys.Detail.Back.Help().Key+": back to all projects") + "\n\n")
+
+	// 1. Title (+ Featured Badge)
+	titleRow := m.Styles.Highlight.Render(utils.SafeString(p, "title"))
+	if featured, ok := p["featured"].(bool); ok && featured {
+		titleRow += "  " + m.Styles.FeaturedBadge.String()
+	}
+	doc.WriteString(titleRow + "\n\n")
+
+	// 2. Big Picture
+	imgW, imgH := detailImageSize(width)
+	img := m.art("detail", m.ProjectCursor)
+	if img == "" {
+		img = m.Renderer.Place(imgW, imgH, lipgloss.Center, lipgloss.Center, "Loading...")
+	}
+	doc.WriteString(m.Renderer.PlaceHorizontal(contentWidth, lipgloss.Center, img) + "\n\n")
+
+	// 3. Links (full URLs, they're clickable in most terminals)
+	if github := utils.SafeString(p, "githubUrl"); github != "" {
+		doc.WriteString(utils.MakeLink("  Source Code: "+github, github) + "\n")
+	}
+	if live := utils.SafeString(p, "liveUrl"); live != "" {
+		doc.WriteString(utils.MakeLink("🔗  Live Demo:   "+live, live) + "\n")
+	}
+	doc.WriteString("\n")
+
+	// 4. All Tags (wrapped onto as many lines as needed)
+	var tags []string
+	for _, t := range projectTags(p) {
+		tags = append(tags, m.Styles.Tag.Render(t))
+	}
+	if len(tags) > 0 {
+		doc.WriteString(wrapChips(tags, contentWidth) + "\n\n")
+	}
+
+	// 5. Full Description
+	doc.WriteString(m.Renderer.NewStyle().
+		Width(contentWidth).
+		Foreground(m.Theme.Text).
+		Render(utils.SafeString(p, "description")) + "\n")
+
+	// 6. Demo GIF (plays while this page is open)
+	if demo, ok := m.demoImage(); ok {
+		doc.WriteString("\n" + m.Styles.SectionTitle.Render("Demo") + "\n")
+
+		var body string
+		switch {
+		case m.Demo.Err != nil && m.isCurrentDemo(m.Demo):
+			body = m.imagePlaceholder(24, 5)
+		case len(m.Demo.Frames) > 0 && m.Demo.Source == demo.URL:
+			body = m.Demo.Frames[m.DemoFrame]
+		default:
+			body = m.Spinner.View() + " Loading demo..."
+		}
+		doc.WriteString(m.Renderer.PlaceHorizontal(contentWidth, lipgloss.Center, body) + "\n")
+
+		hint := m.Keys.Detail.Demo.Help().Key + ": pause"
+		if m.DemoPaused {
+			hint = "⏸ paused • " + m.Keys.Detail.Demo.Help().Key + ": play"
+		}
+		doc.WriteString(m.Renderer.PlaceHorizontal(contentWidth, lipgloss.Center, m.Styles.Subtle.Render(hint)) + "\n")
+	}
+
+	return doc.String()
+}
@@ -0,0 +1,79 @@
+package tui
+
+import (
+	"sync"
+	"time"
+)
+
+// ContactDraft is a snapshot of the contact form, saved per visitor so a
+// dropped SSH connection doesn't lose what they were typing.
+type ContactDraft struct {
+	Values  map[string]interface{} // Field name -> string or []string
+	SavedAt time.Time
+}
+
+// Global Draft Storage (keyed by public-key fingerprint)
+var (
+	draftStore    = map[string]ContactDraft{}
+	draftMutex    sync.Mutex
+	draftDuration = 24 * time.Hour
+)
+
+// saveDraft stores the draft for a fingerprint and drops expired ones
+func saveDraft(fingerprint string, draft ContactDraft) {
+	if fingerprint == "" {
+		return
+	}
+	draftMutex.Lock()
+	defer draftMutex.Unlock()
+
+	draft.SavedAt = time.Now()
+	draftStore[fingerprint] = draft
+
+	// Prune old drafts so the map doesn't grow forever
+	for key, d := range draftStore {
+		if time.Since(d.SavedAt) > draftDuration {
+			delete(draftStore, key)
+		}
+	}
+}
+
+// loadDraft returns the saved draft for a fingerprint, if it is still fresh
+func loadDraft(fingerprint string) (ContactDraft, bool) {
+	if fingerprint == "" {
+		return ContactDraft{}, false
+	}
+	draftMutex.Lock()
+	defer draftMutex.Unlock()
+
+	draft, ok := draftStore[fingerprint]
+	if !ok || time.Since(draft.SavedAt) > draftDuration {
+		return ContactDraft{}, false
+	}
+	return draft, true
+}
+
+// clearDraft removes the draft once the form has been submitted
+func clearDraft(fingerprint string) {
+	draftMutex.Lock()
+	defer draftMutex.Unlock()
+	delete(draftStore, fingerprint)
+}
+
+// autosaveDraft snapshots the current contact form state for this visitor
+func (m *Model) autosaveDraft() {
+	saveDraft(m.Fingerprint, ContactDraft{Values: m.formValues()})
+}
+
+// restoreDraft fills the contact form from a previously saved draft
+func (m *Model) restoreDraft() {
+	draft, ok := loadDraft(m.Fingerprint)
+	if !ok {
+		return
+	}
+	for i := range m.Form {
+		if val, ok := draft.Values[m.Form[i].Field.Name]; ok {
+			m.setFieldValue(&m.Form[i], val)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package tui
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// focusDirection maps the card keys (arrows / hjkl) to a move on the grid
+func (m Model) focusDirection(msg tea.KeyMsg) (dx, dy int, ok bool) {
+	switch {
+	case key.Matches(msg, m.Keys.Cards.Up):
+		return 0, -1, true
+	case key.Matches(msg, m.Keys.Cards.Down):
+		return 0, 1, true
+	case key.Matches(msg, m.Keys.Cards.Left):
+		return -1, 0, true
+	case key.Matches(msg, m.Keys.Cards.Right):
+		return 1, 0, true
+	}
+	return 0, 0, false
+}
+
+// isFocused checks if a card is the one selected on the current tab
+func (m Model) isFocused(kind string, index int) bool {
+	switch m.ActiveTab {
+	case 0:
+		return m.HomeFocus.Kind == kind && m.HomeFocus.Index == index
+	case 1:
+		return kind == "projects" && index == m.ProjectCursor
+	case 2:
+		return kind == "positions" && index == m.PositionCursor
+	case 3:
+		return kind == "services" && index == m.ServiceCursor
+	case 4:
+		return kind == "blogs" && index == m.BlogCursor
+	}
+	return false
+}
+
+// selectCard makes a card of the current tab the selected one, redraws the
+// tab and scrolls the card into view
+func (m *Model) selectCard(kind string, index int) {
+	switch m.ActiveTab {
+	case 0:
+		m.HomeFocus = cardRect{Kind: kind, Index: index}
+	case 1:
+		m.ProjectCursor = index
+	case 2:
+		m.PositionCursor = index
+	case 3:
+		m.ServiceCursor = index
+	case 4:
+		m.BlogCursor = index
+	}
+
+	content, rects := m.contentLayout(m.Viewport.Width)
+	m.Viewport.SetContent(content)
+	for _, r := range rects {
+		if r.Kind == kind && r.Index == index {
+			m.scrollToRect(r)
+		}
+	}
+}
+
+// moveFocus selects the nearest card in a direction, following however
+// the tab lays its cards out (1, 2 or 3 columns). It reports false when
+// there is nothing that way, so the key can scroll or switch tabs instead.
+func (m *Model) moveFocus(dx, dy int) bool {
+	_, rects := m.contentLayout(m.Viewport.Width)
+
+	var cur cardRect
+	found := false
+	for _, r := range rects {
+		if m.isFocused(r.Kind, r.Index) {
+			cur, found = r, true
+		}
+	}
+	if !found {
+		// Nothing selected yet: start with the first card on screen
+		for _, r := range rects {
+			if r.Y+r.H > m.Viewport.YOffset && r.Y < m.Viewport.YOffset+m.Viewport.Height {
+				m.selectCard(r.Kind, r.Index)
+				return true
+			}
+		}
+		return false
+	}
+
+	best, bestScore := cardRect{}, -1
+	for _, r := range rects {
+		var gap, drift int
+		switch {
+		case dy > 0 && r.Y >= cur.Y+cur.H:
+			gap, drift = r.Y-(cur.Y+cur.H), abs((r.X+r.W/2)-(cur.X+cur.W/2))
+		case dy < 0 && r.Y+r.H <= cur.Y:
+			gap, drift = cur.Y-(r.Y+r.H), abs((r.X+r.W/2)-(cur.X+cur.W/2))
+		case dx > 0 && r.X >= cur.X+cur.W && sameRow(r, cur):
+			gap = r.X - (cur.X + cur.W)
+		case dx < 0 && r.X+r.W <= cur.X && sameRow(r, cur):
+			gap = cur.X - (r.X + r.W)
+		default:
+			continue
+		}
+		// The closest row (or column) first, then the card most in line
+		if score := gap*1000 + drift; bestScore < 0 || score < bestScore {
+			best, bestScore = r, score
+		}
+	}
+	if bestScore < 0 {
+		return false
+	}
+	m.selectCard(best.Kind, best.Index)
+	return true
+}
+
+// sameRow checks if two cards sit side by side
+func sameRow(a, b cardRect) bool {
+	return a.Y < b.Y+b.H && b.Y < a.Y+a.H
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// openHomeCard opens the card selected on Home: a project's page, an
+// article, or the service on the Services tab
+func (m *Model) openHomeCard() (tea.Cmd, bool) {
+	switch m.HomeFocus.Kind {
+	case "projects":
+		return m.openProject(m.HomeFocus.Index), true
+	case "blogs":
+		m.openBlog(m.HomeFocus.Index)
+		return nil, true
+	case "services":
+		m.goToTab(3)
+		m.selectCard("services", m.HomeFocus.Index)
+		return nil, true
+	}
+	return nil, false
+}
@@ -0,0 +1,332 @@
+package tui
+
+import (
+	"fmt"
+	"log"
+	"net/mail"
+	"portfolioTUI/config"
+	"portfolioTUI/database"
+	"portfolioTUI/utils"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textarea"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// formInput is the live state of one schema-defined contact form field
+type formInput struct {
+	Field config.FormField
+
+	Text textinput.Model // text, email
+	Area textarea.Model  // textarea
+
+	Choice  int      // radio, select: index into the options
+	Checked []string // checkbox: values of the checked options
+	Cursor  int      // checkbox: highlighted row
+}
+
+// formOption is one choice of a radio / select / checkbox field
+type formOption struct {
+	Value string
+	Label string
+	Meta  string // Extra info shown next to the label (price • timeframe)
+}
+
+// newFormInputs creates the inputs for a form definition
+func newFormInputs(fields []config.FormField, styles Styles) []formInput {
+	inputs := make([]formInput, len(fields))
+
+	for i, f := range fields {
+		in := formInput{Field: f}
+
+		switch f.Type {
+		case config.FieldTextarea:
+			ta := textarea.New()
+			ta.Placeholder = f.Placeholder
+			ta.CharLimit = 500
+			if f.CharLimit > 0 {
+				ta.CharLimit = f.CharLimit
+			}
+			ta.SetHeight(5)
+			if f.Height > 0 {
+				ta.SetHeight(f.Height)
+			}
+			ta.ShowLineNumbers = false
+			ta.FocusedStyle = styles.TextareaFocused
+			ta.BlurredStyle = styles.TextareaBlurred
+			ta.Cursor.Style = styles.Cursor
+			ta.Cursor.TextStyle = styles.Cursor
+			ta.SetValue(f.Default)
+			in.Area = ta
+
+		case config.FieldText, config.FieldEmail:
+			ti := textinput.New()
+			ti.Placeholder = f.Placeholder
+			ti.PlaceholderStyle = styles.Placeholder
+			ti.PromptStyle = styles.Cursor
+			ti.TextStyle = styles.Cursor
+			ti.Cursor.Style = styles.Cursor
+			ti.Cursor.TextStyle = styles.Cursor
+			ti.CharLimit = 50
+			if f.CharLimit > 0 {
+				ti.CharLimit = f.CharLimit
+			}
+			ti.SetValue(f.Default)
+			in.Text = ti
+
+		case config.FieldRadio, config.FieldSelect:
+			for j, opt := range f.Options {
+				if opt == f.Default {
+					in.Choice = j
+				}
+			}
+		}
+
+		inputs[i] = in
+	}
+
+	return inputs
+}
+
+// isTextual reports whether the field swallows printable keys
+func (in formInput) isTextual() bool {
+	switch in.Field.Type {
+	case config.FieldText, config.FieldEmail, config.FieldTextarea:
+		return true
+	}
+	return false
+}
+
+// text returns what's typed in a text box, untrimmed
+func (in formInput) text() string {
+	if in.Field.Type == config.FieldTextarea {
+		return in.Area.Value()
+	}
+	return in.Text.Value()
+}
+
+// isChecked reports whether a checkbox option is checked
+func (in formInput) isChecked(value string) bool {
+	for _, v := range in.Checked {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// toggle checks or unchecks a checkbox option
+func (in *formInput) toggle(value string) {
+	for i, v := range in.Checked {
+		if v == value {
+			in.Checked = append(in.Checked[:i:i], in.Checked[i+1:]...)
+			return
+		}
+	}
+	in.Checked = append(in.Checked, value)
+}
+
+// --- MODEL HELPERS ---
+
+// submitIndex is the FocusIndex of the submit button (after the last field)
+func (m Model) submitIndex() int {
+	return len(m.Form)
+}
+
+// focusedInput returns the field under focus, or nil on the submit button
+func (m *Model) focusedInput() *formInput {
+	if m.FocusIndex >= 0 && m.FocusIndex < len(m.Form) {
+		return &m.Form[m.FocusIndex]
+	}
+	return nil
+}
+
+// isTypingInForm reports whether the focused field is a text box
+func (m Model) isTypingInForm() bool {
+	return m.FocusIndex >= 0 && m.FocusIndex < len(m.Form) && m.Form[m.FocusIndex].isTextual()
+}
+
+// fieldOptions resolves the choices for a field (static or from a collection)
+func (m Model) fieldOptions(f config.FormField) []formOption {
+	var opts []formOption
+
+	if f.OptionsFrom == "services" {
+		for _, s := range m.Services {
+			opts = append(opts, formOption{
+				Value: utils.SafeID(s, "_id"),
+				Label: utils.SafeString(s, "title"),
+				Meta:  fmt.Sprintf("%s • %s", utils.SafeString(s, "price"), utils.SafeString(s, "timeframe")),
+			})
+		}
+		return opts
+	}
+
+	for _, o := range f.Options {
+		opts = append(opts, formOption{Value: o, Label: o})
+	}
+	return opts
+}
+
+// fieldValue returns a field's value: a string, or []string for checklists
+func (m Model) fieldValue(in formInput) interface{} {
+	switch in.Field.Type {
+	case config.FieldTextarea:
+		return in.Area.Value()
+	case config.FieldText, config.FieldEmail:
+		return strings.TrimSpace(in.Text.Value())
+	case config.FieldRadio, config.FieldSelect:
+		opts := m.fieldOptions(in.Field)
+		if in.Choice >= 0 && in.Choice < len(opts) {
+			return opts[in.Choice].Value
+		}
+		return ""
+	case config.FieldCheckbox:
+		return append([]string(nil), in.Checked...)
+	}
+	return ""
+}
+
+// setFieldValue fills a field from a saved value (see fieldValue)
+func (m Model) setFieldValue(in *formInput, val interface{}) {
+	switch in.Field.Type {
+	case config.FieldTextarea:
+		if s, ok := val.(string); ok {
+			in.Area.SetValue(s)
+		}
+	case config.FieldText, config.FieldEmail:
+		if s, ok := val.(string); ok {
+			in.Text.SetValue(s)
+		}
+	case config.FieldRadio, config.FieldSelect:
+		for i, opt := range m.fieldOptions(in.Field) {
+			if opt.Value == val {
+				in.Choice = i
+			}
+		}
+	case config.FieldCheckbox:
+		// Only keep options that still exist
+		list, _ := val.([]string)
+		in.Checked = nil
+		for _, v := range list {
+			for _, opt := range m.fieldOptions(in.Field) {
+				if opt.Value == v {
+					in.Checked = append(in.Checked, v)
+				}
+			}
+		}
+	}
+}
+
+// formValues collects every field value keyed by field name
+func (m Model) formValues() map[string]interface{} {
+	values := make(map[string]interface{}, len(m.Form))
+	for _, in := range m.Form {
+		values[in.Field.Name] = m.fieldValue(in)
+	}
+	return values
+}
+
+// validateForm checks required and email fields, returning errors by field name
+func (m Model) validateForm() map[string]string {
+	errs := map[string]string{}
+
+	for _, in := range m.Form {
+		val := m.fieldValue(in)
+
+		empty := false
+		switch v := val.(type) {
+		case string:
+			empty = strings.TrimSpace(v) == ""
+		case []string:
+			empty = len(v) == 0
+		}
+
+		if in.Field.Required && empty {
+			errs[in.Field.Name] = in.Field.Label + " is required"
+			continue
+		}
+
+		if in.Field.Type == config.FieldEmail && !empty {
+			if _, err := mail.ParseAddress(val.(string)); err != nil {
+				errs[in.Field.Name] = "Please enter a valid email address"
+			}
+		}
+	}
+
+	return errs
+}
+
+// selectedServiceIDs returns what's checked in the services checklist (if any)
+func (m Model) selectedServiceIDs() []string {
+	for _, in := range m.Form {
+		if in.Field.Type == config.FieldCheckbox && in.Field.OptionsFrom == "services" {
+			return in.Checked
+		}
+	}
+	return nil
+}
+
+// checkFormFields drops fields whose value could never be saved: names the
+// contact document sets itself (name, createdAt...) and repeated names
+func checkFormFields(fields []config.FormField) []config.FormField {
+	var kept []config.FormField
+	seen := map[string]bool{}
+	for _, f := range fields {
+		switch {
+		case f.Name == "":
+			log.Printf("Contact form: field %q has no name, skipping it", f.Label)
+		case database.ReservedContactKey(f.Name):
+			log.Printf("Contact form: field %q uses a name the contact document sets itself, skipping it", f.Name)
+		case seen[f.Name]:
+			log.Printf("Contact form: field %q appears twice, skipping the second one", f.Name)
+		default:
+			seen[f.Name] = true
+			kept = append(kept, f)
+		}
+	}
+	return kept
+}
+
+// setFormFields swaps in a new form definition, keeping values by field name
+func (m *Model) setFormFields(fields []config.FormField) {
+	values := m.formValues()
+
+	m.FormFields = fields
+	m.Form = newFormInputs(fields, m.Styles)
+	for i := range m.Form {
+		if val, ok := values[m.Form[i].Field.Name]; ok {
+			m.setFieldValue(&m.Form[i], val)
+		}
+	}
+
+	if m.FocusIndex > m.submitIndex() {
+		m.FocusIndex = 0
+	}
+	m.updateFocus()
+}
+
+// resetForm clears every field back to its default
+func (m *Model) resetForm() {
+	m.Form = newFormInputs(m.FormFields, m.Styles)
+	m.FormErrors = nil
+	m.FocusIndex = 0
+	m.updateFocus()
+}
+
+// updateFormInputs forwards a message to the focused text field
+func (m *Model) updateFormInputs(msg tea.Msg) tea.Cmd {
+	in := m.focusedInput()
+	if in == nil {
+		return nil
+	}
+
+	var cmd tea.Cmd
+	switch in.Field.Type {
+	case config.FieldTextarea:
+		in.Area, cmd = in.Area.Update(msg)
+	case config.FieldText, config.FieldEmail:
+		in.Text, cmd = in.Text.Update(msg)
+	}
+	return cmd
+}
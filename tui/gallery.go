@@ -0,0 +1,208 @@
+package tui
+
+import (
+	"fmt"
+	"portfolioTUI/utils"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// galleryImage is one screenshot of a project
+type galleryImage struct {
+	URL      string
+	Caption  string
+	Animated bool // GIFs play instead of showing their first frame
+}
+
+// projectImages reads a project's "images" field, where each entry is a
+// URL or a { url, caption } document, after its "demoGif" if it has one.
+// Projects without screenshots show their thumbnail instead.
+func projectImages(p bson.M) []galleryImage {
+	var images []galleryImage
+
+	if demo := utils.SafeString(p, "demoGif"); demo != "" {
+		images = append(images, galleryImage{URL: demo, Caption: "Demo"})
+	}
+
+	if raw, ok := p["images"].(bson.A); ok {
+		for _, entry := range raw {
+			switch e := entry.(type) {
+			case string:
+				images = append(images, galleryImage{URL: e})
+			case bson.M:
+				images = append(images, galleryImage{URL: utils.SafeString(e, "url"), Caption: utils.SafeString(e, "caption")})
+			case bson.D:
+				doc := bson.M{}
+				for _, el := range e {
+					doc[el.Key] = el.Value
+				}
+				images = append(images, galleryImage{URL: utils.SafeString(doc, "url"), Caption: utils.SafeString(doc, "caption")})
+			}
+		}
+	}
+
+	// Drop entries without a URL, mark GIFs
+	kept := images[:0]
+	for _, img := range images {
+		if img.URL != "" {
+			img.Animated = isGIF(img.URL)
+			kept = append(kept, img)
+		}
+	}
+	images = kept
+
+	if len(images) == 0 {
+		if url := utils.SafeString(p, "imageUrl"); url != "" {
+			images = append(images, galleryImage{URL: url, Caption: utils.SafeString(p, "title")})
+		}
+	}
+	return images
+}
+
+// isGIF checks the file extension of an image URL (ignoring the query)
+func isGIF(url string) bool {
+	path, _, _ := strings.Cut(url, "?")
+	return strings.HasSuffix(strings.ToLower(path), ".gif")
+}
+
+// galleryImages returns the screenshots of the project in the gallery
+func (m Model) galleryImages() []galleryImage {
+	if m.GalleryProject < 0 || m.GalleryProject >= len(m.Projects) {
+		return nil
+	}
+	return projectImages(m.Projects[m.GalleryProject])
+}
+
+// gallerySize is the image area: the whole terminal minus the title,
+// caption and help lines
+func (m Model) gallerySize() (w, h int) {
+	return max(m.Width-4, 10), max(m.Height-6, 3)
+}
+
+// openGallery shows the selected project's screenshots full-screen
+func (m *Model) openGallery() tea.Cmd {
+	if m.ProjectCursor >= len(m.Projects) || !m.projectMatchesTags(m.Projects[m.ProjectCursor]) {
+		return nil
+	}
+	m.GalleryOpen = true
+	m.GalleryProject = m.ProjectCursor
+	m.GalleryIndex = 0
+	m.clearArt("gallery")
+	m.stopDemo()
+
+	if len(m.galleryImages()) == 0 {
+		return m.showToast("This project has no images", toastDuration)
+	}
+	return tea.Batch(m.generateGalleryImages()...)
+}
+
+// closeGallery goes back to the Projects tab (or the project's page,
+// whose demo starts again)
+func (m *Model) closeGallery() tea.Cmd {
+	m.GalleryOpen = false
+	m.clearArt("gallery")
+	m.stopDemo()
+	return m.loadDemo()
+}
+
+// moveGallery shows the previous / next screenshot (wrapping around)
+func (m *Model) moveGallery(delta int) tea.Cmd {
+	n := len(m.galleryImages())
+	if n == 0 {
+		return nil
+	}
+	m.GalleryIndex = (m.GalleryIndex + delta + n) % n
+	m.stopDemo()
+	return tea.Batch(m.generateGalleryImages()...)
+}
+
+// generateGalleryImages renders the screenshot on screen, and the next one
+// in the background so browsing forward feels instant. GIFs are only
+// loaded once they are on screen.
+func (m Model) generateGalleryImages() []tea.Cmd {
+	images := m.galleryImages()
+	if !m.GalleryOpen || len(images) == 0 {
+		return nil
+	}
+	if images[m.GalleryIndex].Animated {
+		return []tea.Cmd{m.loadDemo()}
+	}
+
+	opts := m.ImageRender
+	opts.Width, opts.Height = m.gallerySize()
+
+	var cmds []tea.Cmd
+	for i, priority := range []int{utils.PriorityVisible, utils.PriorityBackground} {
+		index := (m.GalleryIndex + i) % len(images)
+		if i > 0 && (index == m.GalleryIndex || images[index].Animated) {
+			break
+		}
+		if m.art("gallery", index) != "" {
+			continue
+		}
+		opts.Source = images[index].URL
+		cmds = append(cmds, utils.GenerateAsciiImage("gallery", index, opts, priority))
+	}
+	return cmds
+}
+
+// renderGallery draws the full-screen gallery
+func (m Model) renderGallery() string {
+	images := m.galleryImages()
+	w, h := m.gallerySize()
+
+	var project bson.M
+	if m.GalleryProject < len(m.Projects) {
+		project = m.Projects[m.GalleryProject]
+	}
+	title := m.Styles.GalleryTitle.Render(utils.SafeString(project, "title"))
+	if len(images) > 0 {
+		title += "  " + m.Styles.GalleryCounter.Render(fmt.Sprintf("%d / %d", m.GalleryIndex+1, len(images)))
+	}
+
+	_, animated := m.demoImage()
+	if animated && m.DemoPaused {
+		title += "  " + m.Styles.GalleryCounter.Render("⏸ paused")
+	}
+
+	var body, caption string
+	switch {
+	case len(images) == 0:
+		body = "No images for this project"
+	case animated && m.Demo.Err != nil && m.isCurrentDemo(m.Demo):
+		body = m.imagePlaceholder(24, 5)
+		caption = images[m.GalleryIndex].Caption
+	case animated && len(m.Demo.Frames) > 0:
+		body = m.Demo.Frames[m.DemoFrame]
+		caption = images[m.GalleryIndex].Caption
+	case !animated && m.art("gallery", m.GalleryIndex) != "":
+		body = m.art("gallery", m.GalleryIndex)
+		caption = images[m.GalleryIndex].Caption
+	default:
+		body = fmt.Sprintf("%s Loading image...", m.Spinner.View())
+		caption = images[m.GalleryIndex].Caption
+	}
+
+	k := m.Keys
+	keys := []key.Binding{k.Detail.Prev, k.Detail.Next, k.Detail.Back, k.Global.Help, k.Global.Quit}
+	if animated {
+		keys = append([]key.Binding{k.Detail.Demo}, keys...)
+	}
+	help := m.Help.ShortHelpView(keys)
+	if m.Toast != "" {
+		help = m.Renderer.NewStyle().Foreground(m.Theme.Success).Bold(true).Render(m.Toast) + m.PendingCopy
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Center,
+		m.Renderer.PlaceHorizontal(m.Width, lipgloss.Center, title),
+		"",
+		m.Renderer.Place(m.Width, h, lipgloss.Center, lipgloss.Center, body),
+		m.Renderer.PlaceHorizontal(m.Width, lipgloss.Center, m.Styles.GalleryCaption.Width(w).Align(lipgloss.Center).Render(strings.TrimSpace(caption))),
+		"",
+		m.Renderer.PlaceHorizontal(m.Width, lipgloss.Center, help),
+	)
+}
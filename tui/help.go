@@ -0,0 +1,129 @@
+package tui
+
+import (
+	"portfolioTUI/config"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// keyGroup is a titled set of bindings, one section of the help overlay
+type keyGroup struct {
+	Title string
+	Keys  []key.Binding
+}
+
+// newHelp is the footer's help line, in the session's colors
+func newHelp(r *lipgloss.Renderer, t Theme) help.Model {
+	h := help.New()
+	h.Styles.ShortKey = r.NewStyle().Foreground(t.Subtext)
+	h.Styles.ShortDesc = r.NewStyle().Foreground(t.Muted)
+	h.Styles.ShortSeparator = r.NewStyle().Foreground(t.Muted)
+	h.Styles.Ellipsis = h.Styles.ShortSeparator
+	return h
+}
+
+// keyContext is what the keys do right now (gallery, project page, tag bar,
+// contact form...)
+func (m Model) keyContext() keyGroup {
+	k := m.Keys
+	switch {
+	case m.GalleryOpen:
+		return keyGroup{"Gallery", []key.Binding{k.Detail.Prev, k.Detail.Next, k.Detail.Demo, k.Detail.Back, k.Global.Images}}
+	case m.ActiveTab == 1 && m.ProjectDetail:
+		return keyGroup{"Project page", []key.Binding{k.Detail.Back, k.Detail.Demo, k.Detail.Gallery}}
+	case m.ActiveTab == 1 && m.TagMode:
+		return keyGroup{"Tag filter", []key.Binding{k.Tags.Left, k.Tags.Right, k.Tags.Toggle, k.Tags.MatchAll, k.Tags.Clear, k.Tags.Done}}
+	case m.ActiveTab == 1:
+		return keyGroup{"Projects", []key.Binding{k.Cards.Up, k.Cards.Down, k.Cards.Open, k.Cards.Gallery, k.Cards.Tags}}
+	case m.ActiveTab == 4 && m.BlogReader:
+		return keyGroup{"Article", []key.Binding{k.Detail.Back, k.Cards.Up, k.Cards.Down, k.Global.PageDown}}
+	case m.ActiveTab == 4:
+		return keyGroup{"Articles", []key.Binding{k.Cards.Up, k.Cards.Down, k.Cards.Left, k.Cards.Right, k.Cards.Open}}
+	case m.ActiveTab == 5 && m.ContactReview:
+		return keyGroup{"Review message", []key.Binding{k.Contact.Confirm, k.Contact.Edit}}
+	case m.ActiveTab == 5:
+		return keyGroup{"Contact form", []key.Binding{k.Contact.Prev, k.Contact.Next, k.Contact.Skip,
+			k.Contact.OptionPrev, k.Contact.OptionNext, k.Contact.Toggle, k.Contact.Submit}}
+	case m.ActiveTab == 0 && m.HomeFocus.Kind != "":
+		return keyGroup{"Cards", []key.Binding{k.Cards.Up, k.Cards.Down, k.Cards.Left, k.Cards.Right, k.Cards.Open}}
+	}
+	return keyGroup{"Cards", []key.Binding{k.Cards.Up, k.Cards.Down, k.Cards.Left, k.Cards.Right}}
+}
+
+// keyGroups are the sections of the help overlay, what works here first
+func (m Model) keyGroups() []keyGroup {
+	g := m.Keys.Global
+	return []keyGroup{
+		m.keyContext(),
+		{"Tabs", []key.Binding{g.NextTab, g.PrevTab, g.Home, g.Projects, g.Experience, g.Services, g.Blogs, g.Contact}},
+		{"Everywhere", []key.Binding{g.Search, g.Palette, g.Yank, g.Images, g.Theme, g.Mouse, g.PageUp, g.PageDown, g.Help, g.Quit}},
+	}
+}
+
+// footerHelp is the short help line under the content: "?", this
+// context's keys, then the global ones, as many as fit on one line
+func (m Model) footerHelp() string {
+	g := m.Keys.Global
+	keys := append([]key.Binding{g.Help}, m.keyContext().Keys...)
+	keys = append(keys, g.NextTab, g.Search, g.Palette, g.Quit)
+
+	// (help.Model only cuts the line when its "…" still fits, so drop
+	// keys from the end ourselves)
+	width := max(m.Width-4, 20)
+	for len(keys) > 1 && lipgloss.Width(m.Help.ShortHelpView(keys)) > width {
+		keys = keys[:len(keys)-1]
+	}
+	return m.Help.ShortHelpView(keys)
+}
+
+// renderHelp draws every key that works here, over the whole screen
+func (m Model) renderHelp() string {
+	keyStyle := m.Styles.Highlight
+	descStyle := m.Renderer.NewStyle().Foreground(m.Theme.Text)
+
+	var sections []string
+	for _, group := range m.keyGroups() {
+		var keys []key.Binding
+		for _, b := range group.Keys {
+			if b.Enabled() {
+				keys = append(keys, b)
+			}
+		}
+
+		// Keys line up in a column per section
+		keyWidth := 0
+		for _, b := range keys {
+			keyWidth = max(keyWidth, lipgloss.Width(keyLabel(b.Keys())))
+		}
+		lines := []string{m.Styles.SectionTitle.Render(group.Title)}
+		for _, b := range keys {
+			lines = append(lines, keyStyle.Width(keyWidth+2).Render(keyLabel(b.Keys()))+descStyle.Render(b.Help().Desc))
+		}
+		sections = append(sections, strings.Join(lines, "\n"))
+	}
+
+	// Side by side when they fit, stacked otherwise
+	columns := make([]string, len(sections))
+	for i, s := range sections {
+		if i > 0 {
+			s = m.Renderer.NewStyle().PaddingLeft(4).Render(s)
+		}
+		columns[i] = s
+	}
+	body := lipgloss.JoinHorizontal(lipgloss.Top, columns...)
+	if lipgloss.Width(body)+6 > m.Width {
+		body = strings.Join(sections, "\n\n")
+	}
+
+	box := m.Styles.FocusedBorder.Padding(0, 1).Render(body)
+	closing := slices.Concat(m.Keys.Global.Help.Keys(), m.Keys.List.Close.Keys())
+	closeKeys := key.NewBinding(key.WithKeys(closing...), key.WithHelp(keyLabel(closing), "close"))
+	help := m.Styles.GalleryHelp.Render("Key layout: "+config.KEYMAP+" • ") + m.Help.ShortHelpView([]key.Binding{closeKeys})
+
+	return m.Renderer.Place(m.Width, m.Height, lipgloss.Center, lipgloss.Center,
+		lipgloss.JoinVertical(lipgloss.Center, box, "", help))
+}
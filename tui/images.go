@@ -0,0 +1,216 @@
+package tui
+
+import (
+	"fmt"
+	"portfolioTUI/utils"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// Collections that have a picture on their cards
+var imageCollections = []string{"projects", "positions", "blogs"}
+
+// How long the terminal must stay the same size before images are redrawn
+var resizeDebounce = 300 * time.Millisecond
+
+// Names shown when the visitor switches image style
+var imageModeNames = map[utils.ImageMode]string{
+	utils.ModeASCII:     "ASCII",
+	utils.ModeHalfBlock: "Half blocks",
+	utils.ModeSixel:     "Sixel",
+	utils.ModeKitty:     "Kitty graphics",
+}
+
+// imagePlaceholder is shown on a card when its image failed to load
+func (m Model) imagePlaceholder(width, height int) string {
+	return m.Renderer.NewStyle().
+		Width(width).
+		Height(height).
+		Align(lipgloss.Center, lipgloss.Center).
+		Background(m.Theme.Surface).
+		Foreground(m.Theme.Subtext).
+		Render("🖼\nimage unavailable")
+}
+
+// artSlot is the key of a card's image in Model.Art
+func artSlot(collection string, index int) string {
+	return fmt.Sprintf("%s/%d", collection, index)
+}
+
+// art returns the rendered image of a card, "" while it is still loading.
+// Art is kept per session: the data documents are shared by every visitor.
+func (m Model) art(collection string, index int) string {
+	return m.Art[artSlot(collection, index)]
+}
+
+// clearArt forgets a collection's images (its documents were replaced)
+func (m *Model) clearArt(collection string) {
+	for key := range m.Art {
+		if strings.HasPrefix(key, collection+"/") {
+			delete(m.Art, key)
+			delete(m.Failed, key)
+		}
+	}
+}
+
+// collectionData returns the documents of a collection with images
+func (m Model) collectionData(collection string) []bson.M {
+	switch collection {
+	case "projects":
+		return m.Projects
+	case "positions":
+		return m.Experience
+	case "blogs":
+		return m.Blogs
+	}
+	return nil
+}
+
+// cardImageSize is the size (in cells) of a collection's card picture for
+// a content width. The card renderers size their image column from it, so
+// the art always fills its slot; the aspect ratios are the original
+// 32x15, 30x15 and 40x12.
+func cardImageSize(collection string, width int) (w, h int) {
+	switch collection {
+	case "projects":
+		// Home cards are the narrowest, the Projects tab reuses the same art
+		w = min(max(featuredCardWidth(width)*2/5, 16), 32)
+		h = w * 15 / 32
+	case "positions":
+		w = positionLogoWidth(width) - 2
+		h = w / 2
+	case "blogs":
+		w = min(blogCardWidth(width)-4, 48)
+		h = min(w*3/10, 12)
+	}
+	return w, h
+}
+
+// generateImages renders the card images of the given collections (all of
+// them by default) for the current layout and image style. Nothing happens
+// before the first resize: only then do we know how big the cards are.
+func (m Model) generateImages(collections ...string) []tea.Cmd {
+	if m.Viewport.Width == 0 {
+		return nil
+	}
+	if len(collections) == 0 {
+		collections = imageCollections
+	}
+
+	var cmds []tea.Cmd
+	for _, c := range collections {
+		data := m.collectionData(c)
+		if len(data) == 0 {
+			continue
+		}
+		opts := m.ImageRender
+		opts.Width, opts.Height = cardImageSize(c, m.Viewport.Width)
+
+		// What's on screen is fetched before the other tabs
+		priority := utils.PriorityBackground
+		if m.showsCollection(c) {
+			priority = utils.PriorityVisible
+		}
+		cmds = append(cmds, utils.GenerateImagesCmds(c, data, opts, priority)...)
+	}
+	return cmds
+}
+
+// showsCollection reports whether the active tab has cards of a collection
+func (m Model) showsCollection(collection string) bool {
+	switch collection {
+	case "projects":
+		return m.ActiveTab == 0 || m.ActiveTab == 1
+	case "positions":
+		return m.ActiveTab == 2
+	case "blogs":
+		return m.ActiveTab == 0 || m.ActiveTab == 4
+	}
+	return false
+}
+
+// prioritizeImages moves the pictures of the active tab ahead in the shared
+// queue (they may have been queued while another tab was showing)
+func (m Model) prioritizeImages() {
+	var sources []string
+	for _, c := range imageCollections {
+		if !m.showsCollection(c) {
+			continue
+		}
+		for _, item := range m.collectionData(c) {
+			if url, ok := utils.ImageSource(c, item); ok {
+				sources = append(sources, url)
+			}
+		}
+	}
+	if len(sources) > 0 {
+		utils.PrioritizeImages(sources)
+	}
+}
+
+// imageSizesChanged reports whether any card picture has a different size
+// at the current width than it had at oldWidth
+func (m Model) imageSizesChanged(oldWidth int) bool {
+	for _, c := range imageCollections {
+		ow, oh := cardImageSize(c, oldWidth)
+		nw, nh := cardImageSize(c, m.Viewport.Width)
+		if ow != nw || oh != nh {
+			return true
+		}
+	}
+	return false
+}
+
+// isCurrentArt reports whether an image result matches the current image
+// style and size (results from before a switch or resize are stale)
+func (m Model) isCurrentArt(msg utils.AsciiIamge) bool {
+	if msg.Mode != m.ImageRender.Mode {
+		return false
+	}
+
+	// Gallery screenshots fill the terminal
+	if msg.CollectionName == "gallery" {
+		images := m.galleryImages()
+		w, h := m.gallerySize()
+		return m.GalleryOpen && msg.Width == w && msg.Height == h &&
+			msg.Index < len(images) && images[msg.Index].URL == msg.Source
+	}
+
+	// The big picture on a project's page
+	if msg.CollectionName == "detail" {
+		w, h := detailImageSize(m.Viewport.Width)
+		return m.ProjectDetail && msg.Index == m.ProjectCursor && msg.Width == w && msg.Height == h &&
+			msg.Index < len(m.Projects) && detailImageURL(m.Projects[msg.Index]) == msg.Source
+	}
+
+	w, h := cardImageSize(msg.CollectionName, m.Viewport.Width)
+	return msg.Width == w && msg.Height == h &&
+		msg.Index < len(m.collectionData(msg.CollectionName))
+}
+
+// cycleImageMode switches to the next image style this terminal supports
+// and renders every image again (old art stays up until the new one lands)
+func (m *Model) cycleImageMode() tea.Cmd {
+	if len(m.ImageModes) < 2 {
+		return m.showToast("No other image style available for this terminal", toastDuration)
+	}
+
+	next := m.ImageModes[0]
+	for i, mode := range m.ImageModes {
+		if mode == m.ImageRender.Mode {
+			next = m.ImageModes[(i+1)%len(m.ImageModes)]
+		}
+	}
+	m.ImageRender.Mode = next
+
+	m.clearArt("gallery")
+	m.clearArt("detail")
+	cmds := append(m.generateImages(), m.generateGalleryImages()...)
+	cmds = append(cmds, m.generateDetailImages()...)
+	cmds = append(cmds, m.showToast("Images: "+imageModeNames[next], toastDuration))
+	return tea.Batch(cmds...)
+}
@@ -0,0 +1,325 @@
+package tui
+
+import (
+	"log"
+	"portfolioTUI/config"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/viewport"
+)
+
+// KeyMap is every key binding, grouped by where it applies. The footer
+// and the help overlay ("?") are built from it, so remapped keys show up
+// there too.
+type KeyMap struct {
+	Global  GlobalKeys
+	Cards   CardKeys
+	Tags    TagKeys
+	Detail  DetailKeys
+	Contact ContactKeys
+	List    ListKeys
+}
+
+// GlobalKeys work on every tab
+type GlobalKeys struct {
+	NextTab    key.Binding
+	PrevTab    key.Binding
+	Home       key.Binding
+	Projects   key.Binding
+	Experience key.Binding
+	Services   key.Binding
+	Blogs      key.Binding
+	Contact    key.Binding
+	Search     key.Binding
+	Palette    key.Binding
+	Yank       key.Binding
+	Images     key.Binding
+	Theme      key.Binding
+	Mouse      key.Binding
+	PageUp     key.Binding
+	PageDown   key.Binding
+	Help       key.Binding
+	Quit       key.Binding
+}
+
+// CardKeys move between cards (Home to Blogs), Up and Down also scroll
+type CardKeys struct {
+	Up      key.Binding
+	Down    key.Binding
+	Left    key.Binding
+	Right   key.Binding
+	Open    key.Binding
+	Gallery key.Binding
+	Tags    key.Binding
+}
+
+// TagKeys pick tags in the Projects filter bar
+type TagKeys struct {
+	Left     key.Binding
+	Right    key.Binding
+	Toggle   key.Binding
+	MatchAll key.Binding
+	Clear    key.Binding
+	Done     key.Binding
+}
+
+// DetailKeys work on a project's page, in the article reader and the gallery
+type DetailKeys struct {
+	Back    key.Binding
+	Demo    key.Binding
+	Gallery key.Binding
+	Prev    key.Binding
+	Next    key.Binding
+}
+
+// ContactKeys drive the contact form and its review screen
+type ContactKeys struct {
+	Prev       key.Binding
+	Next       key.Binding
+	Skip       key.Binding // Next field without walking a checklist's options
+	OptionPrev key.Binding
+	OptionNext key.Binding
+	Toggle     key.Binding
+	Submit     key.Binding
+	Confirm    key.Binding
+	Edit       key.Binding
+}
+
+// ListKeys drive the search and command palette results
+type ListKeys struct {
+	Up     key.Binding
+	Down   key.Binding
+	Select key.Binding
+	Close  key.Binding
+}
+
+func binding(desc string, keys ...string) key.Binding {
+	return key.NewBinding(key.WithKeys(keys...), key.WithHelp(keyLabel(keys[:min(len(keys), 2)]), desc))
+}
+
+// keyLabel is how the keys read in the help: "↑/k" (the footer shows the
+// first two, the help overlay all of them)
+func keyLabel(keys []string) string {
+	arrows := strings.NewReplacer("up", "↑", "down", "↓", "left", "←", "right", "→", " ", "space")
+	var labels []string
+	for _, k := range keys {
+		if k == "shift+tab" || strings.HasPrefix(k, "pg") {
+			labels = append(labels, k)
+			continue
+		}
+		labels = append(labels, arrows.Replace(k))
+	}
+	return strings.Join(labels, "/")
+}
+
+// DefaultKeyMap is the layout everyone gets unless KEYMAP says otherwise
+func DefaultKeyMap() KeyMap {
+	return KeyMap{
+		Global: GlobalKeys{
+			NextTab:    binding("next tab", "right", "tab"),
+			PrevTab:    binding("previous tab", "left", "shift+tab"),
+			Home:       binding("home", "H"),
+			Projects:   binding("projects", "P"),
+			Experience: binding("experience", "E"),
+			Services:   binding("services", "S"),
+			Blogs:      binding("blogs", "B"),
+			Contact:    binding("contact", "C"),
+			Search:     binding("search", "/"),
+			Palette:    binding("commands", "ctrl+k"),
+			Yank:       binding("copy link", "y"),
+			Images:     binding("image style", "i"),
+			Theme:      binding("theme", "T"),
+			Mouse:      binding("mouse on/off", "M"),
+			PageUp:     binding("page up", "pgup", "b"),
+			PageDown:   binding("page down", "pgdown", " ", "f"),
+			Help:       binding("help", "?"),
+			Quit:       binding("quit", "q", "ctrl+c"),
+		},
+		Cards: CardKeys{
+			Up:      binding("up", "up", "k"),
+			Down:    binding("down", "down", "j"),
+			Left:    binding("left", "left", "h"),
+			Right:   binding("right", "right", "l"),
+			Open:    binding("open", "enter"),
+			Gallery: binding("gallery", "g"),
+			Tags:    binding("filter by tag", "t"),
+		},
+		Tags: TagKeys{
+			Left:     binding("previous tag", "left", "h"),
+			Right:    binding("next tag", "right", "l"),
+			Toggle:   binding("toggle tag", " ", "enter"),
+			MatchAll: binding("match any/all", "m"),
+			Clear:    binding("clear", "c"),
+			Done:     binding("done", "t", "esc"),
+		},
+		Detail: DetailKeys{
+			Back:    binding("back", "esc", "backspace"),
+			Demo:    binding("play/pause demo", " "),
+			Gallery: binding("gallery", "g"),
+			Prev:    binding("previous image", "left", "h"),
+			Next:    binding("next image", "right", "l"),
+		},
+		Contact: ContactKeys{
+			Prev:       binding("previous field", "up"),
+			Next:       binding("next field", "down"),
+			Skip:       binding("skip to next field", "tab"),
+			OptionPrev: binding("previous option", "left"),
+			OptionNext: binding("next option", "right"),
+			Toggle:     binding("check option", " ", "x"),
+			Submit:     binding("review & send", "enter"),
+			Confirm:    binding("confirm", "enter", "y"),
+			Edit:       binding("edit", "e", "esc"),
+		},
+		List: ListKeys{
+			Up:     binding("up", "up", "ctrl+p", "shift+tab"),
+			Down:   binding("down", "down", "ctrl+n", "tab"),
+			Select: binding("open", "enter"),
+			Close:  binding("close", "esc"),
+		},
+	}
+}
+
+// keyPresets are the layouts KEYMAP can pick, written like KEYBINDINGS
+var keyPresets = map[string]string{
+	"default": "",
+	// hjkl only, [ ] for tabs, : for commands
+	"vim": "global.next_tab=],tab;global.prev_tab=[,shift+tab;global.palette=:,ctrl+k;" +
+		"global.page_up=ctrl+b,pgup;global.page_down=ctrl+f,pgdown;" +
+		"cards.up=k;cards.down=j;cards.left=h;cards.right=l;" +
+		"tags.left=h;tags.right=l;detail.prev=h;detail.next=l;detail.back=esc,ctrl+o",
+	// ctrl+p/n/b/f to move, ctrl+s to search, alt+x for commands, ctrl+g to go back
+	"emacs": "global.next_tab=alt+f,tab;global.prev_tab=alt+b,shift+tab;global.search=ctrl+s,/;global.palette=alt+x,ctrl+k;" +
+		"global.page_up=alt+v,pgup;global.page_down=ctrl+v,pgdown;global.quit=ctrl+x,ctrl+c;" +
+		"cards.up=ctrl+p,up;cards.down=ctrl+n,down;cards.left=ctrl+b,left;cards.right=ctrl+f,right;" +
+		"tags.left=ctrl+b,left;tags.right=ctrl+f,right;tags.done=t,ctrl+g,esc;" +
+		"detail.prev=ctrl+b,left;detail.next=ctrl+f,right;detail.back=ctrl+g,esc,backspace;" +
+		"contact.prev=ctrl+p,up;contact.next=ctrl+n,down;contact.edit=e,ctrl+g,esc;list.close=ctrl+g,esc",
+}
+
+// named lists the bindings by the names KEYBINDINGS uses ("cards.up")
+func (k *KeyMap) named() map[string]*key.Binding {
+	return map[string]*key.Binding{
+		"global.next_tab":     &k.Global.NextTab,
+		"global.prev_tab":     &k.Global.PrevTab,
+		"global.home":         &k.Global.Home,
+		"global.projects":     &k.Global.Projects,
+		"global.experience":   &k.Global.Experience,
+		"global.services":     &k.Global.Services,
+		"global.blogs":        &k.Global.Blogs,
+		"global.contact":      &k.Global.Contact,
+		"global.search":       &k.Global.Search,
+		"global.palette":      &k.Global.Palette,
+		"global.yank":         &k.Global.Yank,
+		"global.images":       &k.Global.Images,
+		"global.theme":        &k.Global.Theme,
+		"global.mouse":        &k.Global.Mouse,
+		"global.page_up":      &k.Global.PageUp,
+		"global.page_down":    &k.Global.PageDown,
+		"global.help":         &k.Global.Help,
+		"global.quit":         &k.Global.Quit,
+		"cards.up":            &k.Cards.Up,
+		"cards.down":          &k.Cards.Down,
+		"cards.left":          &k.Cards.Left,
+		"cards.right":         &k.Cards.Right,
+		"cards.open":          &k.Cards.Open,
+		"cards.gallery":       &k.Cards.Gallery,
+		"cards.tags":          &k.Cards.Tags,
+		"tags.left":           &k.Tags.Left,
+		"tags.right":          &k.Tags.Right,
+		"tags.toggle":         &k.Tags.Toggle,
+		"tags.match_all":      &k.Tags.MatchAll,
+		"tags.clear":          &k.Tags.Clear,
+		"tags.done":           &k.Tags.Done,
+		"detail.back":         &k.Detail.Back,
+		"detail.demo":         &k.Detail.Demo,
+		"detail.gallery":      &k.Detail.Gallery,
+		"detail.prev":         &k.Detail.Prev,
+		"detail.next":         &k.Detail.Next,
+		"contact.prev":        &k.Contact.Prev,
+		"contact.next":        &k.Contact.Next,
+		"contact.skip":        &k.Contact.Skip,
+		"contact.option_prev": &k.Contact.OptionPrev,
+		"contact.option_next": &k.Contact.OptionNext,
+		"contact.toggle":      &k.Contact.Toggle,
+		"contact.submit":      &k.Contact.Submit,
+		"contact.confirm":     &k.Contact.Confirm,
+		"contact.edit":        &k.Contact.Edit,
+		"list.up":             &k.List.Up,
+		"list.down":           &k.List.Down,
+		"list.select":         &k.List.Select,
+		"list.close":          &k.List.Close,
+	}
+}
+
+// Override rebinds keys from a spec like "cards.up=k,up;global.quit=ctrl+c".
+// An empty list ("global.yank=") turns a binding off. Unknown names are
+// logged and skipped.
+func (k *KeyMap) Override(spec string) {
+	bindings := k.named()
+	for _, entry := range strings.Split(spec, ";") {
+		name, keys, ok := strings.Cut(strings.TrimSpace(entry), "=")
+		if !ok {
+			if entry = strings.TrimSpace(entry); entry != "" {
+				log.Printf("Key bindings: %q is not name=keys", entry)
+			}
+			continue
+		}
+		b, found := bindings[strings.ToLower(strings.TrimSpace(name))]
+		if !found {
+			log.Printf("Key bindings: unknown binding %q", name)
+			continue
+		}
+
+		var list []string
+		for _, s := range strings.Split(keys, ",") {
+			if s = strings.TrimSpace(s); s == "space" {
+				list = append(list, " ")
+			} else if s != "" {
+				list = append(list, s)
+			}
+		}
+		if len(list) == 0 {
+			b.SetEnabled(false)
+			continue
+		}
+		b.SetKeys(list...)
+		b.SetHelp(keyLabel(list[:min(len(list), 2)]), b.Help().Desc)
+		b.SetEnabled(true)
+	}
+}
+
+// ViewportKeys makes the viewport scroll with the same keys
+func (k KeyMap) ViewportKeys() viewport.KeyMap {
+	vk := viewport.DefaultKeyMap()
+	vk.Up, vk.Down = k.Cards.Up, k.Cards.Down
+	vk.PageUp, vk.PageDown = k.Global.PageUp, k.Global.PageDown
+	return vk
+}
+
+// The key map is read from the environment once, then shared by every session
+var (
+	keyMapOnce   sync.Once
+	sharedKeyMap KeyMap
+)
+
+// loadKeyMap builds the keys from KEYMAP (preset) and KEYBINDINGS (overrides)
+func loadKeyMap() KeyMap {
+	keyMapOnce.Do(func() {
+		sharedKeyMap = DefaultKeyMap()
+		preset, ok := keyPresets[strings.ToLower(config.KEYMAP)]
+		if !ok {
+			var names []string
+			for name := range keyPresets {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			log.Printf("Key bindings: unknown KEYMAP %q (have %s), using default", config.KEYMAP, strings.Join(names, ", "))
+		}
+		sharedKeyMap.Override(preset)
+		sharedKeyMap.Override(config.KEYBINDINGS)
+	})
+	return sharedKeyMap
+}
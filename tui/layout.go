@@ -0,0 +1,120 @@
+package tui
+
+import "strings"
+
+// cardRect is where a card (or form field) sits in the viewport content,
+// in cells and lines. Renderers return them next to their content so mouse
+// clicks can be matched against exactly what was drawn.
+type cardRect struct {
+	Kind  string // "projects", "blogs", "services", "positions", "field", "submit"
+	Index int    // Into the kind's data (or form fields)
+	X, Y  int
+	W, H  int
+}
+
+// contains checks if a content cell is inside the card
+func (r cardRect) contains(x, y int) bool {
+	return x >= r.X && x < r.X+r.W && y >= r.Y && y < r.Y+r.H
+}
+
+// offsetRects moves rects of a section to where the section starts
+func offsetRects(rects []cardRect, dx, dy int) []cardRect {
+	for i := range rects {
+		rects[i].X += dx
+		rects[i].Y += dy
+	}
+	return rects
+}
+
+// lineCount is the line the next piece of content starts on
+func lineCount(s string) int {
+	return strings.Count(s, "\n")
+}
+
+// centerOffset is the gap left of a block centered in width (lipgloss
+// puts the odd cell on the right, both in Place and in Align)
+func centerOffset(width, block int) int {
+	return max(width-block, 0) / 2
+}
+
+// Breakpoints, in cells
+const (
+	minWidth, minHeight = 40, 12 // Anything smaller only says "terminal too small"
+	narrowWidth         = 100    // Narrower (or shorter than shortHeight) hides the footer's links
+	shortHeight         = 30
+	stackWidth          = 88 // Content narrower than this puts its cards in one column
+)
+
+// tooSmall is true when the layout can't fit the terminal at all
+func (m Model) tooSmall() bool {
+	return m.Width < minWidth || m.Height < minHeight
+}
+
+// compactFooter is true when the footer only has room for the key help
+func (m Model) compactFooter() bool {
+	return m.Width < narrowWidth || m.Height < shortHeight
+}
+
+// contentWidth is the width of the viewport: 80% of the screen, but on
+// small terminals as much of it as 80 columns need
+func (m Model) contentWidth() int {
+	return max(int(float64(m.Width)*0.8), min(m.Width-2, 80))
+}
+
+// stacked is true when a section this wide shows its cards one per row
+func stacked(width int) bool {
+	return width < stackWidth
+}
+
+// contentLayout renders the current tab and returns where its cards are
+func (m Model) contentLayout(width int) (string, []cardRect) {
+	switch m.ActiveTab {
+	case 0:
+		return m.layoutHome(width)
+
+	case 1: // Projects
+		if m.ProjectDetail {
+			return m.renderProjectDetail(width), nil
+		}
+		return m.layoutProjects(width)
+
+	case 2:
+		// Experience is as wide as the viewport
+		return m.layoutPositions(m.contentWidth())
+
+	case 3: // Servicese
+		return m.layoutServices(width, false)
+
+	case 4:
+		if m.BlogReader {
+			return m.renderBlogReader(width), nil
+		}
+		return m.layoutBlogs(width, false)
+
+	case 5: // Contact
+		return m.layoutContact(width)
+	}
+	return "", nil
+}
+
+// cardAt finds the card under a cell of the viewport content
+func (m Model) cardAt(x, y int) (cardRect, bool) {
+	_, rects := m.contentLayout(m.Viewport.Width)
+	for _, r := range rects {
+		if r.contains(x, y) {
+			return r, true
+		}
+	}
+	return cardRect{}, false
+}
+
+// scrollToRect scrolls the viewport just enough to show a card
+func (m *Model) scrollToRect(r cardRect) {
+	top, bottom := r.Y, r.Y+r.H
+
+	if top < m.Viewport.YOffset {
+		m.Viewport.SetYOffset(top)
+	} else if bottom > m.Viewport.YOffset+m.Viewport.Height {
+		m.Viewport.SetYOffset(max(bottom-m.Viewport.Height, top))
+	}
+}
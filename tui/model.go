@@ -2,15 +2,20 @@ package tui
 
 import (
 	"portfolioTUI/config"
+	"portfolioTUI/database"
+	"portfolioTUI/utils"
 
+	"github.com/charmbracelet/bubbles/help"
 	"github.com/charmbracelet/bubbles/spinner"
-	"github.com/charmbracelet/bubbles/textarea"
 	"github.com/charmbracelet/bubbles/textinput"
 	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/ssh"
+	"github.com/charmbracelet/wish/bubbletea"
+	"github.com/muesli/termenv"
 	"go.mongodb.org/mongo-driver/v2/bson"
+	gossh "golang.org/x/crypto/ssh"
 )
 
 type Model struct {
@@ -27,63 +32,136 @@ type Model struct {
 
 	Viewport viewport.Model
 
-	// --- CONTACT FORM STATE ---
-	FirstNameInput textinput.Model
-	LastNameInput  textinput.Model
-	EmailInput     textinput.Model
-	MsgInput       textarea.Model
+	// Per-session styling (colors follow the visitor's own terminal)
+	Renderer *lipgloss.Renderer
+	Theme    Theme // Switched with "T"
+	Styles   Styles
+
+	// Projects tab: selected card, its detail page and full-screen gallery
+	ProjectCursor  int
+	ProjectDetail  bool // Detail page of the selected project is open
+	ProjectScroll  int  // List scroll position to return to
+	GalleryOpen    bool
+	GalleryProject int // Index into Projects
+	GalleryIndex   int // Index into the project's images
+
+	// Projects tab: tag filter bar
+	TagFilter   map[string]bool // Selected tags (lowercase)
+	TagMatchAll bool            // AND instead of OR
+	TagMode     bool            // Picking tags ("t")
+	TagCursor   int             // Into projectTagCounts
+
+	// Blogs tab: selected article and the reader
+	BlogCursor int
+	BlogReader bool // Selected article is open
+	BlogScroll int  // List scroll position to return to
+
+	// Selected cards of the other tabs (arrows / hjkl)
+	HomeFocus      cardRect // Kind and Index only, none until a key is pressed
+	PositionCursor int
+	ServiceCursor  int
+
+	// Key bindings (KEYMAP / KEYBINDINGS), the footer's help line and "?"
+	Keys     KeyMap
+	Help     help.Model
+	HelpOpen bool
+
+	// Search overlay ("/")
+	Search        textinput.Model
+	SearchOpen    bool
+	SearchResults []searchResult // Grouped by kind
+	SearchCursor  int
+
+	// Command palette (ctrl+k)
+	Palette       textinput.Model
+	PaletteOpen   bool
+	PaletteItems  []paletteCommand // Matching the query
+	PaletteCursor int
+
+	// Animated GIF playback (only while it is on screen)
+	Demo       utils.AnimationMsg // Frames of the GIF being played
+	DemoFrame  int
+	DemoPaused bool
+	DemoSeq    int // Bumped when playback (re)starts, older ticks are ignored
 
-	UserType        string // "Professional" or "Student"
-	SelectedService int    // Index of m.Services
-	FocusIndex      int    // 0-6
+	// --- CONTACT FORM STATE ---
+	// Fields come from config.ContactFormFields or the "forms" collection
+	FormFields []config.FormField
+	Form       []formInput
+	FormErrors map[string]string // Validation messages by field name
+	FocusIndex int               // 0..len(Form), the last one is the submit button
 
 	// Contact Specific States
-	ContactLoading bool // True when "Submit" is clicked
-	FormSuccess    bool // True after successful submit
+	ContactReview  bool                   // True while the summary screen is shown
+	PendingContact database.ContactSchema // Exact document shown on the summary screen
+	ContactLoading bool                   // True when "Confirm" is clicked
+	ContactFailed  bool                   // True if the last send attempt failed
+	FormSuccess    bool                   // True after successful submit
+
+	// Visitor identity (SHA256 of their SSH public key, "" if none)
+	Fingerprint string
+
+	// Visitor terminal
+	Term               string // TERM sent by the client
+	ClipboardSupported bool   // Whether OSC 52 copy is expected to work
+	MouseEnabled       bool   // Clicks are captured ("M" toggles, off unless MOUSE is set)
+
+	// How images are drawn for this visitor (ASCII / half blocks / Sixel / Kitty)
+	ImageRender utils.ImageOptions
+	ImageModes  []utils.ImageMode // Styles this terminal supports, "i" cycles through them
+	Art         map[string]string // Rendered card images by artSlot()
+	Failed      map[string][2]int // Slots of Art holding a placeholder, by size (redrawn with the theme)
+	ResizeSeq   int               // Bumped on every resize, debounces image regeneration
+
+	// Footer toast (e.g. "Copied email")
+	Toast       string
+	ToastID     int
+	PendingCopy string // OSC 52 sequence written out alongside the toast
 }
 
-func InitialModel(w, h int, data config.AllMessages) Model {
-	s := spinner.New()
-	s.Spinner = spinner.Dot
-	s.Style = lipgloss.NewStyle().Foreground(lipgloss.Color("63")) // Purple spinner
-
-	// 1. Initialize Inputs
-	fn := textinput.New()
-	fn.Placeholder = "Jane"
-	fn.CharLimit = 30
-	fn.Focus() // Start focused
-
-	ln := textinput.New()
-	ln.Placeholder = "Doe"
-	ln.CharLimit = 30
+func InitialModel(w, h int, data config.AllMessages, r *lipgloss.Renderer) Model {
+	if r == nil {
+		r = lipgloss.DefaultRenderer()
+	}
 
-	email := textinput.New()
-	email.Placeholder = "your.email@example.com"
-	email.CharLimit = 50
+	theme := startTheme(r)
 
-	ta := textarea.New()
-	ta.Placeholder = "Tell me about your project..."
-	ta.CharLimit = 500
-	ta.SetHeight(5)
-	ta.ShowLineNumbers = false
+	s := spinner.New()
+	s.Spinner = spinner.Dot
+	s.Style = r.NewStyle().Foreground(theme.Primary)
 
 	model := Model{
-		Width:   w,
-		Height:  h,
-		Loading: true,
-		Spinner: s,
+		Width:    w,
+		Height:   h,
+		Loading:  true,
+		Spinner:  s,
+		Renderer: r,
+		Theme:    theme,
+		Styles:   NewStyles(r, theme),
+		Keys:     loadKeyMap(),
+		Help:     newHelp(r, theme),
+		// ASCII art until the terminal tells us it can do better
+		ImageRender: utils.ImageOptions{
+			Mode:       utils.ModeASCII,
+			Colored:    true,
+			Profile:    r.ColorProfile(),
+			CellWidth:  10,
+			CellHeight: 20,
+		},
+		ImageModes: []utils.ImageMode{utils.ModeASCII},
+		Art:        map[string]string{},
+		Failed:     map[string][2]int{},
+		TagFilter:  map[string]bool{},
 		// Contact Init
-		FirstNameInput: fn,
-		LastNameInput:  ln,
-		EmailInput:     email,
-		MsgInput:       ta,
-		UserType:       "Professional",
 		FocusIndex:     0,
 		ContactLoading: false,
 		FormSuccess:    false,
 	}
 
-	// 3. LOAD THE PRE-FETCHED DATA IMMEDIATELY
+	// 1. Initialize Inputs (the "forms" collection may replace these below)
+	model.setFormFields(config.ContactFormFields)
+
+	// 2. LOAD THE PRE-FETCHED DATA IMMEDIATELY
 	for _, msg := range data {
 		model = updateModelWithData(model, msg)
 	}
@@ -101,5 +179,39 @@ func TeaHandler(s ssh.Session) (tea.Model, []tea.ProgramOption) {
 	if !active {
 		return nil, nil
 	}
-	return InitialModel(pty.Window.Width, pty.Window.Height, data), []tea.ProgramOption{tea.WithAltScreen()}
+
+	// Colors come from this visitor's TERM / COLORTERM / NO_COLOR
+	model := InitialModel(pty.Window.Width, pty.Window.Height, data, bubbletea.MakeRenderer(s))
+	model.Term = pty.Term
+	model.ClipboardSupported = utils.SupportsOSC52(pty.Term, s.Environ())
+
+	// Best image style first: real bitmaps (Sixel / Kitty graphics), then
+	// colored half blocks; ASCII art always works
+	model.ImageModes = nil
+	if mode := utils.DetectImageMode(s, s, pty.Term, s.Environ()); mode != utils.ModeASCII {
+		model.ImageModes = append(model.ImageModes, mode)
+	}
+	if model.ImageRender.Profile != termenv.Ascii {
+		model.ImageModes = append(model.ImageModes, utils.ModeHalfBlock)
+	}
+	model.ImageModes = append(model.ImageModes, utils.ModeASCII)
+	model.ImageRender.Mode = model.ImageModes[0]
+	if pty.Window.WidthPixels > 0 && pty.Window.HeightPixels > 0 && pty.Window.Width > 0 && pty.Window.Height > 0 {
+		model.ImageRender.CellWidth = pty.Window.WidthPixels / pty.Window.Width
+		model.ImageRender.CellHeight = pty.Window.HeightPixels / pty.Window.Height
+	}
+
+	// Restore any unsent contact form for visitors who connect with a key
+	if key := s.PublicKey(); key != nil {
+		model.Fingerprint = gossh.FingerprintSHA256(key)
+		model.restoreDraft()
+	}
+
+	// Mouse capture is opt-in, it takes drag-to-select away from the terminal
+	opts := []tea.ProgramOption{tea.WithAltScreen()}
+	if config.MOUSE {
+		model.MouseEnabled = true
+		opts = append(opts, tea.WithMouseCellMotion())
+	}
+	return model, opts
 }
@@ -0,0 +1,74 @@
+package tui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// handleMouse handles clicks: tabs in the header, cards (projects and
+// articles open, others get selected), and contact form fields (they get
+// the focus). The wheel
+// is left to the viewport.
+func (m *Model) handleMouse(msg tea.MouseMsg) tea.Cmd {
+	if msg.Action != tea.MouseActionPress || msg.Button != tea.MouseButtonLeft {
+		return nil
+	}
+	// Nothing to click on the loading screen and overlays
+	if m.Loading || m.tooSmall() || m.PaletteOpen || m.SearchOpen || m.GalleryOpen || m.HelpOpen {
+		return nil
+	}
+
+	if tab, ok := m.tabAt(msg.X, msg.Y); ok {
+		if tab != m.ActiveTab {
+			m.goToTab(tab)
+		}
+		return nil
+	}
+
+	// Cell of the viewport content (the viewport is centered below the header)
+	x := msg.X - centerOffset(m.Width, m.Viewport.Width)
+	y := msg.Y - lipgloss.Height(m.renderHeader())
+	if x < 0 || x >= m.Viewport.Width || y < 0 || y >= m.Viewport.Height {
+		return nil
+	}
+	y += m.Viewport.YOffset
+
+	// The first line of a project's page or an article is the way back
+	if y == 0 && m.ActiveTab == 1 && m.ProjectDetail {
+		m.closeProjectDetail()
+		return nil
+	}
+	if y == 0 && m.ActiveTab == 4 && m.BlogReader {
+		m.closeBlogReader()
+		return nil
+	}
+
+	r, ok := m.cardAt(x, y)
+	if !ok {
+		return nil
+	}
+	switch r.Kind {
+	case "projects":
+		return m.openProject(r.Index)
+	case "blogs":
+		m.openBlog(r.Index)
+	case "services", "positions":
+		m.selectCard(r.Kind, r.Index)
+	case "field", "submit":
+		if m.ContactReview || m.ContactLoading {
+			return nil
+		}
+		m.FocusIndex = r.Index
+		return m.updateFocus()
+	}
+	return nil
+}
+
+// toggleMouse turns mouse capture on or off for this session
+func (m *Model) toggleMouse() tea.Cmd {
+	m.MouseEnabled = !m.MouseEnabled
+	if !m.MouseEnabled {
+		return tea.Batch(tea.DisableMouse, m.showToast("Mouse off: drag selects text", toastDuration))
+	}
+	return tea.Batch(tea.EnableMouseCellMotion, m.showToast("Mouse on: shift+drag selects text", toastDuration))
+}
@@ -0,0 +1,89 @@
+package tui
+
+import (
+	"fmt"
+	"portfolioTUI/utils"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// blogLink is the article's page on the website
+func blogLink(b bson.M) string {
+	return fmt.Sprintf("https://tarunnayaka.me/Blog/%s", utils.SafeID(b, "_id"))
+}
+
+// openBlogReader shows the selected article, remembering where the list
+// was scrolled to
+func (m *Model) openBlogReader() {
+	if m.BlogCursor >= len(m.Blogs) {
+		return
+	}
+	m.BlogReader = true
+	m.BlogScroll = m.Viewport.YOffset
+	m.Viewport.SetContent(m.renderBlogReader(m.Viewport.Width))
+	m.Viewport.GotoTop()
+}
+
+// openBlog jumps to an article from anywhere
+func (m *Model) openBlog(index int) {
+	if index >= len(m.Blogs) {
+		return
+	}
+	m.goToTab(4)
+	m.selectCard("blogs", index)
+	m.openBlogReader()
+}
+
+// closeBlogReader goes back to the list, where the visitor left it
+func (m *Model) closeBlogReader() {
+	m.BlogReader = false
+	m.Viewport.SetContent(m.renderBlogsSection(m.Viewport.Width, false))
+	m.Viewport.SetYOffset(m.BlogScroll)
+}
+
+// renderBlogReader draws the selected article with its Markdown (or the
+// web editor's HTML) rendered for the terminal
+func (m Model) renderBlogReader(width int) string {
+	if m.BlogCursor >= len(m.Blogs) {
+		return ""
+	}
+	b := m.Blogs[m.BlogCursor]
+	contentWidth := max(width-4, 20)
+
+	doc := strings.Builder{}
+	doc.WriteString(m.Styles.Subtle.Render("← "+m.Keys.Detail.Back.Help().Key+": back to all articles") + "\n\n")
+
+	// 1. Header
+	doc.WriteString(m.Renderer.NewStyle().Width(contentWidth).Render(m.Styles.Highlight.Render(utils.SafeString(b, "title"))) + "\n")
+
+	dateStr := utils.SafeDate(b, "createdAt")
+	if split := strings.Split(dateStr, "T"); len(split) > 0 {
+		dateStr = split[0]
+	}
+	meta := fmt.Sprintf("By %s • %s • %s views", utils.SafeString(b, "author"), dateStr, utils.SafeString(b, "views"))
+	doc.WriteString(m.Renderer.NewStyle().Foreground(m.Theme.Subtext).Render(meta) + "\n")
+	doc.WriteString(m.Styles.Subtle.Render(utils.MakeLink(" Read on the web: "+blogLink(b), blogLink(b))) + "\n\n")
+
+	// 2. Cover (the card's picture)
+	if img := m.art("blogs", m.BlogCursor); img != "" {
+		doc.WriteString(m.Renderer.PlaceHorizontal(contentWidth, lipgloss.Center, img) + "\n")
+	}
+
+	// 3. Article
+	content := utils.SafeString(b, "content")
+	if strings.TrimSpace(content) == "" {
+		doc.WriteString("\n" + m.Styles.Subtle.Render("This article has no content here yet, read it on the web instead.") + "\n")
+		return doc.String()
+	}
+
+	body, err := utils.RenderMarkdown(content, contentWidth, m.Renderer.ColorProfile(), m.Theme.Dark)
+	if err != nil {
+		// Plain text still beats nothing
+		body = m.Renderer.NewStyle().Width(contentWidth).Render(content)
+	}
+	doc.WriteString(body)
+
+	return doc.String()
+}
@@ -0,0 +1,328 @@
+package tui
+
+import (
+	"portfolioTUI/utils"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/sahilm/fuzzy"
+)
+
+// searchKinds are the result groups, in display order, and their tabs
+var searchKinds = []struct {
+	Name string
+	Tab  int
+}{
+	{"Projects", 1},
+	{"Blogs", 4},
+	{"Experience", 2},
+	{"Services", 3},
+}
+
+// searchPerKind caps the results shown in each group
+const searchPerKind = 5
+
+// searchField is one searchable piece of text of an item
+type searchField struct {
+	Kind  int // Index into searchKinds
+	Index int // Index into the kind's data
+	Title string
+	Field string // e.g. "description", "tag"
+	Text  string
+}
+
+// searchCorpus lets fuzzy match every field at once
+type searchCorpus []searchField
+
+func (c searchCorpus) String(i int) string { return c[i].Text }
+func (c searchCorpus) Len() int            { return len(c) }
+
+// searchResult is the best match of one item
+type searchResult struct {
+	searchField
+	Matches []int // Byte offsets of the matched characters in Text
+}
+
+// searchCorpus collects every title, description, tag, company and
+// service name
+func (m Model) searchCorpus() searchCorpus {
+	var c searchCorpus
+	add := func(kind, index int, title, field, text string) {
+		// Newlines would break the result rows (same length keeps the offsets)
+		text = strings.Map(func(r rune) rune {
+			if r == '\n' || r == '\r' || r == '\t' {
+				return ' '
+			}
+			return r
+		}, text)
+		if strings.TrimSpace(text) != "" {
+			c = append(c, searchField{Kind: kind, Index: index, Title: title, Field: field, Text: text})
+		}
+	}
+
+	for i, p := range m.Projects {
+		title := utils.SafeString(p, "title")
+		add(0, i, title, "title", title)
+		for _, tag := range projectTags(p) {
+			add(0, i, title, "tag", tag)
+		}
+		add(0, i, title, "description", utils.SafeString(p, "description"))
+	}
+	for i, b := range m.Blogs {
+		title := utils.SafeString(b, "title")
+		add(1, i, title, "title", title)
+		for _, tag := range projectTags(b) {
+			add(1, i, title, "tag", tag)
+		}
+		add(1, i, title, "description", utils.SafeString(b, "description"))
+	}
+	for i, e := range m.Experience {
+		role, company := utils.SafeString(e, "jobTitle"), utils.SafeString(e, "companyName")
+		title := role + " @ " + company
+		add(2, i, title, "title", role)
+		add(2, i, title, "company", company)
+		add(2, i, title, "description", utils.SafeString(e, "description"))
+	}
+	for i, s := range m.Services {
+		title := utils.SafeString(s, "title")
+		add(3, i, title, "title", title)
+		add(3, i, title, "category", utils.SafeString(s, "category"))
+		add(3, i, title, "description", utils.SafeString(s, "description"))
+	}
+	return c
+}
+
+// runSearch fuzzy-matches the query and keeps each item's best field,
+// grouped by kind
+func (m *Model) runSearch() {
+	m.SearchResults = nil
+	m.SearchCursor = 0
+
+	query := strings.TrimSpace(m.Search.Value())
+	if query == "" {
+		return
+	}
+
+	corpus := m.searchCorpus()
+	best := map[[2]int]bool{}
+	groups := make([][]searchResult, len(searchKinds))
+	for _, match := range fuzzy.FindFrom(query, corpus) {
+		f := corpus[match.Index]
+		item := [2]int{f.Kind, f.Index}
+		if best[item] || len(groups[f.Kind]) >= searchPerKind {
+			continue
+		}
+		best[item] = true
+		groups[f.Kind] = append(groups[f.Kind], searchResult{searchField: f, Matches: match.MatchedIndexes})
+	}
+	for _, g := range groups {
+		m.SearchResults = append(m.SearchResults, g...)
+	}
+}
+
+// openSearch shows the search overlay with an empty query
+func (m *Model) openSearch() tea.Cmd {
+	ti := textinput.New()
+	ti.Prompt = "/ "
+	ti.Placeholder = "Search projects, blogs, experience, services..."
+	ti.PlaceholderStyle = m.Styles.Placeholder
+	ti.PromptStyle = m.Styles.Highlight
+	ti.TextStyle = m.Styles.Cursor
+	ti.Cursor.Style = m.Styles.Cursor
+	ti.Cursor.TextStyle = m.Styles.Cursor
+	ti.CharLimit = 64
+
+	m.Search = ti
+	m.SearchOpen = true
+	m.SearchResults = nil
+	m.SearchCursor = 0
+	return m.Search.Focus()
+}
+
+// closeSearch hides the overlay
+func (m *Model) closeSearch() {
+	m.SearchOpen = false
+	m.Search.Blur()
+}
+
+// updateSearch handles keys while the overlay is open
+func (m *Model) updateSearch(msg tea.KeyMsg) tea.Cmd {
+	switch k := m.Keys.List; {
+	case key.Matches(msg, k.Close):
+		m.closeSearch()
+		return nil
+	case key.Matches(msg, k.Select):
+		if m.SearchCursor < len(m.SearchResults) {
+			return m.openSearchResult(m.SearchResults[m.SearchCursor])
+		}
+		return nil
+	case key.Matches(msg, k.Up):
+		if n := len(m.SearchResults); n > 0 {
+			m.SearchCursor = (m.SearchCursor - 1 + n) % n
+		}
+		return nil
+	case key.Matches(msg, k.Down):
+		if n := len(m.SearchResults); n > 0 {
+			m.SearchCursor = (m.SearchCursor + 1) % n
+		}
+		return nil
+	}
+
+	before := m.Search.Value()
+	var cmd tea.Cmd
+	m.Search, cmd = m.Search.Update(msg)
+	if m.Search.Value() != before {
+		m.runSearch()
+	}
+	return cmd
+}
+
+// openSearchResult opens projects and blogs, and jumps to the card of
+// positions and services
+func (m *Model) openSearchResult(r searchResult) tea.Cmd {
+	m.closeSearch()
+	switch tab := searchKinds[r.Kind].Tab; tab {
+	case 1:
+		return m.openProject(r.Index)
+	case 2:
+		m.goToTab(tab)
+		m.selectCard("positions", r.Index)
+	case 3:
+		m.goToTab(tab)
+		m.selectCard("services", r.Index)
+	case 4:
+		m.openBlog(r.Index)
+	}
+	return nil
+}
+
+// renderSearch draws the overlay: the query, then the results by kind
+func (m Model) renderSearch() string {
+	width := min(m.Width-4, 80)
+	textWidth := max(width-8, 10)
+	search := m.Search
+	search.Width = textWidth
+
+	var lines []string
+	selected := 0
+
+	switch {
+	case strings.TrimSpace(search.Value()) == "":
+		lines = append(lines, m.Styles.Subtle.Render("Type to search titles, descriptions, tags, companies and services"))
+	case len(m.SearchResults) == 0:
+		lines = append(lines, m.Styles.Subtle.Render("No matches"))
+	}
+
+	for i, r := range m.SearchResults {
+		if i == 0 || r.Kind != m.SearchResults[i-1].Kind {
+			if i > 0 {
+				lines = append(lines, "")
+			}
+			lines = append(lines, m.Styles.SectionTitle.UnsetMargins().Render(searchKinds[r.Kind].Name))
+		}
+
+		cursor := "  "
+		titleStyle := m.Renderer.NewStyle().Foreground(m.Theme.Text)
+		if i == m.SearchCursor {
+			cursor = m.Styles.Highlight.Render("▸ ")
+			titleStyle = titleStyle.Bold(true)
+			selected = len(lines)
+		}
+
+		// Matches in the title are highlighted there, others get a line of their own
+		if r.Field == "title" && r.Text == r.Title {
+			lines = append(lines, cursor+m.highlightMatches(r.Text, r.Matches, textWidth, titleStyle))
+			continue
+		}
+		lines = append(lines, cursor+titleStyle.Render(truncate(r.Title, textWidth)))
+		lines = append(lines, "    "+m.Styles.Subtle.Render(r.Field+": ")+
+			m.highlightMatches(r.Text, r.Matches, textWidth-len(r.Field)-4, m.Styles.Subtle))
+	}
+
+	// Keep the selected result on screen
+	if avail := max(m.Height-10, 3); len(lines) > avail {
+		start := min(max(selected-avail/2, 0), len(lines)-avail)
+		lines = lines[start : start+avail]
+	}
+
+	box := m.Styles.FocusedBorder.Width(width).Render(lipgloss.JoinVertical(lipgloss.Left,
+		search.View(),
+		"",
+		strings.Join(lines, "\n"),
+	))
+	help := m.Styles.GalleryHelp.Render(m.Help.ShortHelpView([]key.Binding{m.Keys.List.Up, m.Keys.List.Down, m.Keys.List.Select, m.Keys.List.Close}))
+
+	return m.Renderer.Place(m.Width, m.Height, lipgloss.Center, lipgloss.Center,
+		lipgloss.JoinVertical(lipgloss.Center, box, "", help))
+}
+
+// highlightMatches renders text (cut to width, around the first match)
+// with the matched characters highlighted
+func (m Model) highlightMatches(text string, matches []int, width int, base lipgloss.Style) string {
+	matched := map[int]bool{}
+	for _, i := range matches {
+		matched[i] = true
+	}
+
+	type char struct {
+		r       rune
+		matched bool
+	}
+	var chars []char
+	first := -1
+	for i, r := range text {
+		if matched[i] && first < 0 {
+			first = len(chars)
+		}
+		chars = append(chars, char{r, matched[i]})
+	}
+
+	// Window of the text around the first match
+	width = max(width, 10)
+	prefix, suffix := "", ""
+	if len(chars) > width {
+		start := min(max(first-width/4, 0), len(chars)-width)
+		end := start + width
+		if start > 0 {
+			prefix = "…"
+			start++
+		}
+		if end < len(chars) {
+			suffix = "…"
+			end--
+		}
+		chars = chars[start:end]
+	}
+
+	// Render runs of (un)matched characters
+	hl := m.Styles.Highlight.Underline(true)
+	var b strings.Builder
+	b.WriteString(base.Render(prefix))
+	for i := 0; i < len(chars); {
+		j := i
+		var run strings.Builder
+		for ; j < len(chars) && chars[j].matched == chars[i].matched; j++ {
+			run.WriteRune(chars[j].r)
+		}
+		if chars[i].matched {
+			b.WriteString(hl.Render(run.String()))
+		} else {
+			b.WriteString(base.Render(run.String()))
+		}
+		i = j
+	}
+	b.WriteString(base.Render(suffix))
+	return b.String()
+}
+
+// truncate cuts text to width characters with an ellipsis
+func truncate(text string, width int) string {
+	runes := []rune(text)
+	if len(runes) <= width {
+		return text
+	}
+	return string(runes[:max(width-1, 0)]) + "…"
+}
@@ -1,21 +1,120 @@
 package tui
 
-import "github.com/charmbracelet/lipgloss"
-
-var (
+import (
+	"github.com/charmbracelet/bubbles/textarea"
+	"github.com/charmbracelet/lipgloss"
+)
 
+// Styles holds the shared styles of the UI. They are created from each
+// session's renderer, so every visitor gets the colors their own terminal
+// can show.
+type Styles struct {
 	// Card Styles
-	cardStyle = lipgloss.NewStyle().
-			Border(lipgloss.RoundedBorder()).
-			BorderForeground(lipgloss.Color("63")).
-			Padding(1, 2).
-			MarginRight(1) // Gap between cards
+	Card lipgloss.Style
 
 	// Section Titles
-	sectionTitleStyle = lipgloss.NewStyle().
-				Foreground(lipgloss.Color("205")).
-				Bold(true).
-				Underline(true).
-				MarginBottom(1).
-				MarginTop(2) // Space before new section
-)
+	SectionTitle lipgloss.Style
+
+	Highlight lipgloss.Style
+	Subtle    lipgloss.Style
+
+	// Navigation Bar Styles
+	Tab       lipgloss.Style
+	ActiveTab lipgloss.Style
+
+	// Contact form (matching the web UI)
+	FocusedBorder lipgloss.Style
+	BlurredBorder lipgloss.Style
+	Label         lipgloss.Style
+	Title         lipgloss.Style
+	SubTitle      lipgloss.Style
+	Button        lipgloss.Style
+
+	// Inputs (bubbles would otherwise use the server's own renderer)
+	Placeholder     lipgloss.Style
+	Cursor          lipgloss.Style
+	TextareaFocused textarea.Style
+	TextareaBlurred textarea.Style
+
+	// Projects
+	FeaturedBadge lipgloss.Style // "★ FEATURED"
+	Tag           lipgloss.Style // e.g. [Python]
+	TagSelected   lipgloss.Style // Tags the list is filtered by
+
+	// Gallery
+	GalleryTitle   lipgloss.Style
+	GalleryCounter lipgloss.Style
+	GalleryCaption lipgloss.Style
+	GalleryHelp    lipgloss.Style
+}
+
+// NewStyles builds the styles for one session's renderer and theme
+func NewStyles(r *lipgloss.Renderer, t Theme) Styles {
+	s := Styles{}
+
+	s.Card = r.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Border).
+		Padding(1, 2).
+		MarginRight(1) // Gap between cards
+
+	s.SectionTitle = r.NewStyle().
+		Foreground(t.Accent).
+		Bold(true).
+		Underline(true).
+		MarginBottom(1).
+		MarginTop(2) // Space before new section
+
+	s.Highlight = r.NewStyle().Foreground(t.Accent).Bold(true)
+	s.Subtle = r.NewStyle().Foreground(t.Muted)
+
+	s.Tab = r.NewStyle().Padding(0, 1).Border(lipgloss.NormalBorder(), true).BorderForeground(t.Muted)
+	s.ActiveTab = s.Tab.Border(activeTabBorder, true).BorderForeground(t.Border).Foreground(t.Primary)
+
+	s.FocusedBorder = r.NewStyle().Border(lipgloss.RoundedBorder()).BorderForeground(t.Border).Padding(0, 1)
+	s.BlurredBorder = r.NewStyle().Border(lipgloss.RoundedBorder()).BorderForeground(t.Muted).Padding(0, 1)
+	s.Label = r.NewStyle().Foreground(t.Subtext)
+	s.Title = r.NewStyle().Foreground(t.Primary).Bold(true).Align(lipgloss.Center)
+	s.SubTitle = r.NewStyle().Foreground(t.Muted).Align(lipgloss.Center)
+	s.Button = r.NewStyle().Foreground(t.Inverse).Background(t.Primary).Padding(0, 3).Bold(true)
+
+	// Laid out like the bubbles defaults, in the theme's colors
+	s.Placeholder = r.NewStyle().Foreground(t.Muted)
+	s.Cursor = r.NewStyle()
+	s.TextareaFocused = textarea.Style{
+		Base:             r.NewStyle(),
+		CursorLine:       r.NewStyle().Foreground(t.Text).Background(t.Surface),
+		CursorLineNumber: r.NewStyle().Foreground(t.Subtext),
+		EndOfBuffer:      r.NewStyle().Foreground(t.Surface),
+		LineNumber:       r.NewStyle().Foreground(t.Muted),
+		Placeholder:      s.Placeholder,
+		Prompt:           r.NewStyle().Foreground(t.Muted),
+		Text:             r.NewStyle().Foreground(t.Text),
+	}
+	s.TextareaBlurred = s.TextareaFocused
+	s.TextareaBlurred.CursorLine = r.NewStyle().Foreground(t.Subtext)
+	s.TextareaBlurred.CursorLineNumber = r.NewStyle().Foreground(t.Muted)
+	s.TextareaBlurred.Text = r.NewStyle().Foreground(t.Subtext)
+
+	s.FeaturedBadge = r.NewStyle().
+		Foreground(t.Focus).
+		Background(t.Primary).
+		Bold(true).
+		Padding(0, 1).
+		SetString("★ FEATURED")
+	s.Tag = r.NewStyle().
+		Foreground(t.Info).
+		Background(t.Surface).
+		Padding(0, 1).
+		MarginRight(1)
+	s.TagSelected = s.Tag.
+		Foreground(t.Surface).
+		Background(t.Info)
+
+	s.GalleryTitle = r.NewStyle().Foreground(t.Accent).Bold(true)
+	s.GalleryCounter = r.NewStyle().Foreground(t.Subtext)
+	s.GalleryCaption = r.NewStyle().Foreground(t.Text).Italic(true)
+	s.GalleryHelp = r.NewStyle().Foreground(t.Muted)
+
+	return s
+}
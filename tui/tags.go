@@ -0,0 +1,213 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// tagCount is one entry of the tag filter bar
+type tagCount struct {
+	Tag   string // As first written in the data
+	Count int    // Projects carrying it
+}
+
+// tagKey makes "go" and "Go" the same tag
+func tagKey(tag string) string {
+	return strings.ToLower(strings.TrimSpace(tag))
+}
+
+// projectTagCounts lists every tag with its number of projects, most used first
+func (m Model) projectTagCounts() []tagCount {
+	index := map[string]int{}
+	var counts []tagCount
+	for _, p := range m.Projects {
+		seen := map[string]bool{}
+		for _, t := range projectTags(p) {
+			key := tagKey(t)
+			if key == "" || seen[key] {
+				continue
+			}
+			seen[key] = true
+			if i, ok := index[key]; ok {
+				counts[i].Count++
+				continue
+			}
+			index[key] = len(counts)
+			counts = append(counts, tagCount{Tag: t, Count: 1})
+		}
+	}
+	sort.SliceStable(counts, func(i, j int) bool {
+		if counts[i].Count != counts[j].Count {
+			return counts[i].Count > counts[j].Count
+		}
+		return tagKey(counts[i].Tag) < tagKey(counts[j].Tag)
+	})
+	return counts
+}
+
+// projectMatchesTags checks a project against the selected tags: any of
+// them, or all of them in AND mode
+func (m Model) projectMatchesTags(p bson.M) bool {
+	if len(m.TagFilter) == 0 {
+		return true
+	}
+	has := map[string]bool{}
+	for _, t := range projectTags(p) {
+		has[tagKey(t)] = true
+	}
+	for tag := range m.TagFilter {
+		if has[tag] && !m.TagMatchAll {
+			return true
+		}
+		if !has[tag] && m.TagMatchAll {
+			return false
+		}
+	}
+	return m.TagMatchAll
+}
+
+// visibleProjects returns the indexes of the projects passing the filter
+func (m Model) visibleProjects() []int {
+	var visible []int
+	for i, p := range m.Projects {
+		if m.projectMatchesTags(p) {
+			visible = append(visible, i)
+		}
+	}
+	return visible
+}
+
+// updateTagBar handles keys while picking tags, reporting whether the
+// key was used
+func (m *Model) updateTagBar(msg tea.KeyMsg) bool {
+	counts := m.projectTagCounts()
+	k := m.Keys.Tags
+
+	switch {
+	case key.Matches(msg, k.Left):
+		m.TagCursor = max(m.TagCursor-1, 0)
+	case key.Matches(msg, k.Right):
+		m.TagCursor = min(m.TagCursor+1, max(len(counts)-1, 0))
+	case key.Matches(msg, k.Toggle):
+		if m.TagCursor >= len(counts) {
+			return true
+		}
+		tag := tagKey(counts[m.TagCursor].Tag)
+		if m.TagFilter[tag] {
+			delete(m.TagFilter, tag)
+		} else {
+			m.TagFilter[tag] = true
+		}
+		m.applyTagFilter()
+		return true
+	case key.Matches(msg, k.MatchAll):
+		m.TagMatchAll = !m.TagMatchAll
+		m.applyTagFilter()
+		return true
+	case key.Matches(msg, k.Clear):
+		clear(m.TagFilter)
+		m.applyTagFilter()
+		return true
+	case key.Matches(msg, k.Done):
+		m.TagMode = false
+	default:
+		return false
+	}
+
+	m.Viewport.SetContent(m.renderProject(m.Viewport.Width))
+	return true
+}
+
+// applyTagFilter redraws the list from the top after the filter changed,
+// moving the selection onto a project that is still shown
+func (m *Model) applyTagFilter() {
+	visible := m.visibleProjects()
+	if len(visible) > 0 && !m.projectMatchesTags(m.Projects[min(m.ProjectCursor, len(m.Projects)-1)]) {
+		m.ProjectCursor = visible[0]
+	}
+	m.Viewport.GotoTop()
+	m.selectCard("projects", m.ProjectCursor)
+}
+
+// pruneTagFilter drops selected tags no project has anymore (after a reload)
+func (m *Model) pruneTagFilter() {
+	known := map[string]bool{}
+	for _, tc := range m.projectTagCounts() {
+		known[tagKey(tc.Tag)] = true
+	}
+	for tag := range m.TagFilter {
+		if !known[tag] {
+			delete(m.TagFilter, tag)
+		}
+	}
+}
+
+// renderTagBar draws the tags with their counts above the project cards,
+// and what the list is filtered by
+func (m Model) renderTagBar(width int) string {
+	counts := m.projectTagCounts()
+	if len(counts) == 0 {
+		return ""
+	}
+
+	var chips []string
+	for i, tc := range counts {
+		style := m.Styles.Tag
+		if m.TagFilter[tagKey(tc.Tag)] {
+			style = m.Styles.TagSelected
+		}
+		if m.TagMode && i == min(m.TagCursor, len(counts)-1) {
+			style = style.Underline(true).Bold(true)
+		}
+		chips = append(chips, style.Render(fmt.Sprintf("%s %d", tc.Tag, tc.Count)))
+	}
+
+	doc := strings.Builder{}
+	doc.WriteString(wrapChips(chips, width-4) + "\n")
+
+	if len(m.TagFilter) > 0 {
+		var selected []string
+		for _, tc := range counts {
+			if m.TagFilter[tagKey(tc.Tag)] {
+				selected = append(selected, tc.Tag)
+			}
+		}
+		join := " OR "
+		if m.TagMatchAll {
+			join = " AND "
+		}
+		doc.WriteString(m.Styles.Highlight.Render("Filtered by "+strings.Join(selected, join)) +
+			m.Styles.Subtle.Render(fmt.Sprintf(" • %d of %d projects", len(m.visibleProjects()), len(m.Projects))) + "\n")
+	}
+
+	hint := m.Styles.Subtle.Render(m.Keys.Cards.Tags.Help().Key + " filter by tag")
+	if m.TagMode {
+		k := m.Keys.Tags
+		hint = m.Help.ShortHelpView([]key.Binding{k.Left, k.Right, k.Toggle, k.MatchAll, k.Clear, k.Done})
+	}
+	doc.WriteString(hint + "\n\n")
+	return doc.String()
+}
+
+// wrapChips lays tags out left to right, onto as many lines as needed
+func wrapChips(chips []string, width int) string {
+	var rows []string
+	var row []string
+	for _, chip := range chips {
+		if len(row) > 0 && lipgloss.Width(lipgloss.JoinHorizontal(lipgloss.Top, append(row, chip)...)) > width {
+			rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top, row...))
+			row = nil
+		}
+		row = append(row, chip)
+	}
+	if len(row) > 0 {
+		rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top, row...))
+	}
+	return strings.Join(rows, "\n")
+}
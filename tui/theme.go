@@ -0,0 +1,208 @@
+package tui
+
+import (
+	"fmt"
+	"log"
+	"portfolioTUI/config"
+	"strings"
+	"sync"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Theme names the colors of the UI by what they are for. Styles and
+// renderers only ever use these, never color numbers.
+type Theme struct {
+	Name string
+	Dark bool // Made for dark terminals (also picks the articles' Markdown style)
+
+	Accent  lipgloss.Color // Titles, highlights, icons
+	Primary lipgloss.Color // Active tab, buttons, selected options
+	Border  lipgloss.Color // Card and focused input borders
+	Focus   lipgloss.Color // Selected card, featured badge
+	Text    lipgloss.Color // Body text
+	Subtext lipgloss.Color // Labels, dates, captions
+	Muted   lipgloss.Color // Hints, blurred inputs, placeholders
+	Surface lipgloss.Color // Backgrounds of chips, logo and image placeholders
+	Inverse lipgloss.Color // Text on Primary backgrounds (buttons)
+	Info    lipgloss.Color // Tags, authors, links
+	Success lipgloss.Color // Toasts, "open to work"
+	Warning lipgloss.Color // Certificates
+	Error   lipgloss.Color // Failed sends, form errors
+}
+
+// Built-in themes, the first one is what "dark" terminals got all along
+var (
+	darkTheme = Theme{
+		Name: "dark", Dark: true,
+		Accent: "205", Primary: "63", Border: "63", Focus: "228",
+		Text: "252", Subtext: "245", Muted: "240", Surface: "236", Inverse: "255",
+		Info: "123", Success: "42", Warning: "214", Error: "196",
+	}
+	lightTheme = Theme{
+		Name:   "light",
+		Accent: "162", Primary: "57", Border: "61", Focus: "166",
+		Text: "235", Subtext: "241", Muted: "245", Surface: "254", Inverse: "255",
+		Info: "25", Success: "28", Warning: "130", Error: "160",
+	}
+	highContrastTheme = Theme{
+		Name: "high-contrast", Dark: true,
+		Accent: "11", Primary: "14", Border: "15", Focus: "11",
+		Text: "15", Subtext: "15", Muted: "250", Surface: "0", Inverse: "0",
+		Info: "14", Success: "10", Warning: "11", Error: "9",
+	}
+	solarizedTheme = Theme{
+		Name: "solarized", Dark: true,
+		Accent: "#d33682", Primary: "#6c71c4", Border: "#268bd2", Focus: "#b58900",
+		Text: "#93a1a1", Subtext: "#839496", Muted: "#586e75", Surface: "#073642", Inverse: "#fdf6e3",
+		Info: "#2aa198", Success: "#859900", Warning: "#cb4b16", Error: "#dc322f",
+	}
+)
+
+// themeRoles lists the roles by the names THEMES uses ("accent:#ff79c6")
+func (t *Theme) themeRoles() map[string]*lipgloss.Color {
+	return map[string]*lipgloss.Color{
+		"accent": &t.Accent, "primary": &t.Primary, "border": &t.Border, "focus": &t.Focus,
+		"text": &t.Text, "subtext": &t.Subtext, "muted": &t.Muted, "surface": &t.Surface,
+		"inverse": &t.Inverse, "info": &t.Info, "success": &t.Success, "warning": &t.Warning,
+		"error": &t.Error,
+	}
+}
+
+// parseThemes reads custom themes from a spec like
+// "ocean=base:dark,accent:#ff79c6,border:#6272a4;paper=base:light,accent:125".
+// Each starts from its base (dark unless said otherwise) and overrides the
+// roles it names. Mistakes are logged and skipped.
+func parseThemes(spec string, builtin []Theme) []Theme {
+	var custom []Theme
+	for _, entry := range strings.Split(spec, ";") {
+		name, roles, ok := strings.Cut(strings.TrimSpace(entry), "=")
+		if !ok {
+			if entry = strings.TrimSpace(entry); entry != "" {
+				log.Printf("Themes: %q is not name=role:color,...", entry)
+			}
+			continue
+		}
+
+		t := darkTheme
+		fields := strings.Split(roles, ",")
+		// The base comes first, whatever order the roles are in
+		for _, f := range fields {
+			role, value, _ := strings.Cut(strings.TrimSpace(f), ":")
+			if strings.EqualFold(role, "base") {
+				if base, found := findTheme(builtin, value); found {
+					t = base
+				} else {
+					log.Printf("Themes: unknown base %q for %q", value, name)
+				}
+			}
+		}
+		t.Name = strings.TrimSpace(name)
+
+		colors := t.themeRoles()
+		for _, f := range fields {
+			if strings.TrimSpace(f) == "" {
+				continue
+			}
+			role, value, _ := strings.Cut(strings.TrimSpace(f), ":")
+			role, value = strings.ToLower(strings.TrimSpace(role)), strings.TrimSpace(value)
+			if role == "base" {
+				continue
+			}
+			if c, found := colors[role]; found && value != "" {
+				*c = lipgloss.Color(value)
+			} else {
+				log.Printf("Themes: bad role %q in %q", f, name)
+			}
+		}
+		custom = append(custom, t)
+	}
+	return custom
+}
+
+// findTheme looks a theme up by name
+func findTheme(themes []Theme, name string) (Theme, bool) {
+	for _, t := range themes {
+		if strings.EqualFold(t.Name, strings.TrimSpace(name)) {
+			return t, true
+		}
+	}
+	return Theme{}, false
+}
+
+// The theme list is read from the environment once, then shared by every session
+var (
+	themesOnce   sync.Once
+	sharedThemes []Theme
+)
+
+// loadThemes returns the built-in themes followed by the THEMES ones
+func loadThemes() []Theme {
+	themesOnce.Do(func() {
+		sharedThemes = []Theme{darkTheme, lightTheme, highContrastTheme, solarizedTheme}
+		sharedThemes = append(sharedThemes, parseThemes(config.THEMES, sharedThemes)...)
+	})
+	return sharedThemes
+}
+
+// startTheme is THEME, or on "auto" whatever suits the visitor's terminal
+func startTheme(r *lipgloss.Renderer) Theme {
+	if t, ok := findTheme(loadThemes(), config.THEME); ok {
+		return t
+	}
+	if !strings.EqualFold(config.THEME, "auto") {
+		log.Printf("Themes: unknown THEME %q, picking one for the terminal", config.THEME)
+	}
+	if r.HasDarkBackground() {
+		return darkTheme
+	}
+	return lightTheme
+}
+
+// applyTheme restyles the whole UI (the form keeps what was typed)
+func (m *Model) applyTheme(t Theme) {
+	m.Theme = t
+	m.Styles = NewStyles(m.Renderer, t)
+	m.Help = newHelp(m.Renderer, t)
+	m.Spinner.Style = m.Renderer.NewStyle().Foreground(t.Primary)
+	for slot, size := range m.Failed {
+		m.Art[slot] = m.imagePlaceholder(size[0], size[1])
+	}
+	m.setFormFields(m.FormFields)
+	m.redrawViewport()
+}
+
+// cycleTheme switches to the next theme
+func (m *Model) cycleTheme() tea.Cmd {
+	themes := loadThemes()
+	next := themes[0]
+	for i, t := range themes {
+		if t.Name == m.Theme.Name {
+			next = themes[(i+1)%len(themes)]
+		}
+	}
+	m.applyTheme(next)
+	return m.showToast(fmt.Sprintf("Theme: %s", next.Name), toastDuration)
+}
+
+// themeCommands offer every theme in the palette
+func themeCommands(m Model) []paletteCommand {
+	var cmds []paletteCommand
+	for _, t := range loadThemes() {
+		theme := t
+		cmds = append(cmds, paletteCommand{
+			Title:    "Theme: " + theme.Name,
+			Category: "Theme",
+			Run: func(m *Model) tea.Cmd {
+				m.applyTheme(theme)
+				return nil
+			},
+		})
+	}
+	return cmds
+}
+
+func init() {
+	registerCommands(themeCommands)
+}
@@ -4,28 +4,20 @@ import (
 	"portfolioTUI/config"
 	"portfolioTUI/database"
 	"portfolioTUI/utils"
-	"strings"
 	"time"
 
+	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 )
 
 func (m Model) Init() tea.Cmd {
 	var cmds []tea.Cmd
 	cmds = append(cmds, m.Spinner.Tick)
 
-	// Trigger image generation for the data we just loaded
-	// (Projects, Blogs, etc. need their ASCII art generated now)
-	if len(m.Projects) > 0 {
-		cmds = append(cmds, utils.GenerateImagesCmds("projects", m.Projects)...)
-	}
-	if len(m.Experience) > 0 {
-		cmds = append(cmds, utils.GenerateImagesCmds("positions", m.Experience)...)
-	}
-	if len(m.Blogs) > 0 {
-		cmds = append(cmds, utils.GenerateImagesCmds("blogs", m.Blogs)...)
-	}
+	// Images are generated on the first tea.WindowSizeMsg,
+	// once we know how big the cards are
 
 	return tea.Batch(cmds...)
 }
@@ -40,10 +32,106 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	// --- 1. GLOBAL KEY COMMANDS ---
 	case tea.KeyMsg:
+		k := m.Keys
+
+		// --- COMMAND PALETTE / SEARCH / HELP OVERLAYS (take every key, "q" is typed) ---
+		// ctrl+c always quits, whatever the key map says
+		if m.PaletteOpen || m.SearchOpen || m.HelpOpen {
+			switch {
+			case msg.String() == "ctrl+c":
+				return m, tea.Quit
+			case m.PaletteOpen:
+				return m, m.updatePalette(msg)
+			case m.SearchOpen:
+				return m, m.updateSearch(msg)
+			default:
+				if key.Matches(msg, k.Global.Help, k.List.Close, k.Global.Quit) {
+					m.HelpOpen = false
+				}
+				return m, nil
+			}
+		}
+
 		// Always allow quitting
-		switch msg.String() {
-		case "q", "ctrl+c":
+		switch {
+		case key.Matches(msg, k.Global.Quit):
 			return m, tea.Quit
+		case key.Matches(msg, k.Global.Palette):
+			return m, m.openPalette()
+		}
+
+		// --- GALLERY (full screen, takes every key) ---
+		if m.GalleryOpen {
+			switch {
+			case key.Matches(msg, k.Detail.Prev):
+				cmds = append(cmds, m.moveGallery(-1))
+			case key.Matches(msg, k.Detail.Next):
+				cmds = append(cmds, m.moveGallery(1))
+			case key.Matches(msg, k.Detail.Back, k.Detail.Gallery):
+				cmds = append(cmds, m.closeGallery())
+			case key.Matches(msg, k.Detail.Demo):
+				cmds = append(cmds, m.toggleDemo())
+			case key.Matches(msg, k.Global.Images):
+				cmds = append(cmds, m.cycleImageMode())
+			case key.Matches(msg, k.Global.Help):
+				m.HelpOpen = true
+			}
+			return m, tea.Batch(cmds...)
+		}
+
+		// --- PROJECTS TAB: pick a card, open its page or gallery ---
+		if m.ActiveTab == 1 && m.ProjectDetail {
+			switch {
+			case key.Matches(msg, k.Detail.Back):
+				m.closeProjectDetail()
+				return m, nil
+			case key.Matches(msg, k.Detail.Demo):
+				cmd = m.toggleDemo()
+				m.redrawViewport()
+				return m, cmd
+			case key.Matches(msg, k.Detail.Gallery):
+				return m, m.openGallery()
+			}
+		} else if m.ActiveTab == 1 {
+			if m.TagMode && m.updateTagBar(msg) {
+				return m, nil
+			}
+			switch {
+			case key.Matches(msg, k.Cards.Tags):
+				m.TagMode = true
+				m.redrawViewport()
+				return m, nil
+			case key.Matches(msg, k.Cards.Open):
+				return m, m.openProjectDetail()
+			case key.Matches(msg, k.Cards.Gallery):
+				return m, m.openGallery()
+			}
+		}
+
+		// --- BLOGS TAB: pick an article and read it ---
+		if m.ActiveTab == 4 && m.BlogReader {
+			if key.Matches(msg, k.Detail.Back) {
+				m.closeBlogReader()
+				return m, nil
+			}
+		} else if m.ActiveTab == 4 {
+			if key.Matches(msg, k.Cards.Open) {
+				m.openBlogReader()
+				return m, nil
+			}
+		}
+
+		// --- CARD GRID: arrows / hjkl pick a card (Home to Blogs) ---
+		// Keys with no card that way still scroll or switch tabs
+		if m.ActiveTab < 5 && !m.ProjectDetail && !m.BlogReader {
+			if dx, dy, ok := m.focusDirection(msg); ok && m.moveFocus(dx, dy) {
+				return m, nil
+			}
+			if m.ActiveTab == 0 && key.Matches(msg, k.Cards.Open) {
+				if cmd, ok := m.openHomeCard(); ok {
+					return m, cmd
+				}
+			}
 		}
 
 		// --- 2. CONTACT PAGE SPECIFIC LOGIC (Tab 5) ---
@@ -51,227 +139,276 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 			// A. Handle Success Screen (Reset on Enter)
 			if m.FormSuccess {
-				if msg.String() == "enter" {
+				if key.Matches(msg, k.Contact.Submit) {
 					m.FormSuccess = false
 					m.Viewport.SetContent(m.renderContactSection(m.Viewport.Width))
 				}
 				return m, nil
 			}
 
-			// B. Handle Form Navigation & Interaction
+			// B. Handle Review Screen (Confirm or go back to editing)
+			if m.ContactReview {
+				if m.ContactLoading {
+					return m, nil
+				}
+				switch {
+				case key.Matches(msg, k.Contact.Confirm):
+					// 1. Set Loading State
+					m.ContactLoading = true
+					m.ContactFailed = false
+					m.Viewport.SetContent(m.renderContactSection(m.Viewport.Width))
+
+					// 2. Fire DB Command with the exact reviewed document, stamped now
+					doc := m.PendingContact
+					doc.CreatedAt = time.Now()
+					return m, func() tea.Msg {
+						time.Sleep(500 * time.Millisecond)
+						err := database.InsertContact(doc)
+						if err != nil {
+							return config.FormSubmittedMsg{Success: false}
+						}
+						return config.FormSubmittedMsg{Success: true}
+					}
+
+				case key.Matches(msg, k.Contact.Edit):
+					m.ContactReview = false
+					m.ContactFailed = false
+					m.Viewport.SetContent(m.renderContactSection(m.Viewport.Width))
+					m.Viewport.GotoTop()
+				}
+				return m, nil
+			}
+
+			// C. Handle Form Navigation & Interaction
 			// We intercept navigation keys so they don't trigger global tab switching
-			switch msg.String() {
-			case "up":
+			in := m.focusedInput()
+			isChecklist := in != nil && in.Field.Type == config.FieldCheckbox
+			isChoice := in != nil && (in.Field.Type == config.FieldRadio || in.Field.Type == config.FieldSelect)
+
+			switch {
+			case key.Matches(msg, k.Contact.Prev):
+				// Move inside a checklist before leaving it
+				if isChecklist && in.Cursor > 0 {
+					in.Cursor--
+					m.Viewport.SetContent(m.renderContactSection(m.Viewport.Width))
+					return m, nil
+				}
 				m.FocusIndex--
 				if m.FocusIndex < 0 {
-					m.FocusIndex = 6
+					m.FocusIndex = m.submitIndex()
 				}
 				cmds = append(cmds, m.updateFocus())
 				return m, tea.Batch(cmds...)
 
-			case "down", "tab":
+			case key.Matches(msg, k.Contact.Next, k.Contact.Skip):
+				if key.Matches(msg, k.Contact.Next) && isChecklist && in.Cursor < len(m.fieldOptions(in.Field))-1 {
+					in.Cursor++
+					m.Viewport.SetContent(m.renderContactSection(m.Viewport.Width))
+					return m, nil
+				}
 				m.FocusIndex++
-				if m.FocusIndex > 6 {
+				if m.FocusIndex > m.submitIndex() {
 					m.FocusIndex = 0
 				}
 				cmds = append(cmds, m.updateFocus())
 				return m, tea.Batch(cmds...)
 
-			// Radio Buttons (Index 3) & Service Select (Index 4) Logic
-			case "left", "right":
-				if m.FocusIndex == 3 {
-					// Toggle User Type
-					if m.UserType == "Professional" {
-						m.UserType = "Student"
-					} else {
-						m.UserType = "Professional"
-					}
+			// Checklists: toggle the highlighted option
+			case key.Matches(msg, k.Contact.Toggle) && isChecklist:
+				if opts := m.fieldOptions(in.Field); in.Cursor < len(opts) {
+					in.toggle(opts[in.Cursor].Value)
+					m.autosaveDraft()
 					m.Viewport.SetContent(m.renderContactSection(m.Viewport.Width))
-					return m, nil
+				}
+				return m, nil
 
-				} else if m.FocusIndex == 4 && len(m.Services) > 0 {
-					// Cycle Services
-					if msg.String() == "right" {
-						m.SelectedService++
-						if m.SelectedService >= len(m.Services) {
-							m.SelectedService = 0
-						}
-					} else {
-						m.SelectedService--
-						if m.SelectedService < 0 {
-							m.SelectedService = len(m.Services) - 1
-						}
-					}
-					m.Viewport.SetContent(m.renderContactSection(m.Viewport.Width))
+			// Radio Buttons / Selects cycle, Checklists move their cursor
+			// (otherwise the keys fall through to Global Navigation)
+			case key.Matches(msg, k.Contact.OptionPrev, k.Contact.OptionNext) && (isChoice || isChecklist):
+				n := len(m.fieldOptions(in.Field))
+				if n == 0 {
 					return m, nil
 				}
-				// If not focusing on Radio/Select, let 'left/right' fall through to Global Navigation
+				pos := &in.Choice
+				if isChecklist {
+					pos = &in.Cursor
+				}
+				if key.Matches(msg, k.Contact.OptionNext) {
+					*pos = (*pos + 1) % n
+				} else {
+					*pos = (*pos - 1 + n) % n
+				}
+				if isChoice {
+					m.autosaveDraft()
+				}
+				m.Viewport.SetContent(m.renderContactSection(m.Viewport.Width))
+				return m, nil
 
-			// Submit Button Logic
-			case "enter":
-				if m.FocusIndex == 6 && !m.ContactLoading {
-					// Validation: Ensure First Name and Email are filled
-					if m.FirstNameInput.Value() == "" || m.EmailInput.Value() == "" {
+			// Submit Button Logic (Opens the review screen)
+			case key.Matches(msg, k.Contact.Submit):
+				if m.FocusIndex == m.submitIndex() && !m.ContactLoading {
+					// Validation: required fields & email format come from the schema
+					m.FormErrors = m.validateForm()
+					if len(m.FormErrors) > 0 {
+						m.Viewport.SetContent(m.renderContactSection(m.Viewport.Width))
 						return m, nil
 					}
 
-					// 1. Set Loading State
-					m.ContactLoading = true
-
-					// 2. Prepare Data
-					fName := m.FirstNameInput.Value()
-					lName := m.LastNameInput.Value()
-					email := m.EmailInput.Value()
-					uType := strings.ToLower(m.UserType)
-					msgVal := m.MsgInput.Value()
-
-					// Get Service ID
-					svcID := ""
-					if len(m.Services) > 0 && m.SelectedService >= 0 && m.SelectedService < len(m.Services) {
-						s := m.Services[m.SelectedService]
-						svcID = utils.SafeID(s, "_id")
-					}
-
-					// 3. Update View immediately to show spinner
+					// Build the document now so the summary shows exactly what gets stored
+					m.PendingContact = database.NewContact(m.formValues())
+					m.ContactReview = true
 					m.Viewport.SetContent(m.renderContactSection(m.Viewport.Width))
-
-					// 4. Fire DB Command
-					return m, func() tea.Msg {
-						time.Sleep(500 * time.Millisecond)
-						err := database.InsertContact(fName, lName, email, uType, msgVal, svcID)
-						if err != nil {
-							return config.FormSubmittedMsg{Success: false}
-						}
-						return config.FormSubmittedMsg{Success: true}
-					}
+					m.Viewport.GotoTop()
+					return m, nil
 				}
 			}
 		}
 
 		// --- 3. GLOBAL TAB NAVIGATION ---
 		// Determine if we are typing inside a text box (First, Last, Email, Message)
-		isTyping := m.ActiveTab == 5 && (m.FocusIndex == 0 || m.FocusIndex == 1 || m.FocusIndex == 2 || m.FocusIndex == 5)
+		isTyping := m.ActiveTab == 5 && m.isTypingInForm()
 
 		// Only allow global navigation if we are NOT typing
 		if !isTyping {
-			switch msg.String() {
-
-			// FIX: Added "tab" here so you can navigate!
-			case "right", "tab":
+			switch {
+			case key.Matches(msg, k.Global.NextTab):
 				if m.ActiveTab < 5 {
 					m.ActiveTab++
 					m.refreshViewport()
 				}
-			case "left", "shift+tab":
+			case key.Matches(msg, k.Global.PrevTab):
 				if m.ActiveTab > 0 {
 					m.ActiveTab--
 					m.refreshViewport()
 				}
 
-			// Hotkeys
-			case "H": // Home
-				m.ActiveTab = 0
-				m.refreshViewport()
-			case "P": // Projects
-				m.ActiveTab = 1
-				m.refreshViewport()
-			case "E": // Experience
-				m.ActiveTab = 2
-				m.refreshViewport()
-			case "S": // Services
-				m.ActiveTab = 3
-				m.refreshViewport()
-			case "B": // Blog
-				m.ActiveTab = 4
-				m.refreshViewport()
-			case "C": // Contact
-				m.ActiveTab = 5
-				m.refreshViewport()
+			// Hotkeys (H/P/E/S/B/C, y, i, /, ?...) come from the command registry
+			default:
+				if cmd, ok := m.runHotkey(msg); ok {
+					return m, cmd
+				}
 			}
 		}
 
 	// --- 4. FORM SUBMISSION RESULT ---
 	case config.FormSubmittedMsg:
 		m.ContactLoading = false
+		m.ContactFailed = !msg.Success
 		if msg.Success {
 			m.FormSuccess = true
-			m.FirstNameInput.SetValue("")
-			m.LastNameInput.SetValue("")
-			m.EmailInput.SetValue("")
-			m.MsgInput.SetValue("")
-			m.FocusIndex = 0
+			m.ContactReview = false
+			m.resetForm()
+			clearDraft(m.Fingerprint)
 		}
 		m.Viewport.SetContent(m.renderContactSection(m.Viewport.Width))
 		return m, nil
 
-	// --- 5. DATA FETCHING ---
+	// --- 5. TOASTS ---
+	case config.ToastExpiredMsg:
+		if msg.ID == m.ToastID {
+			m.Toast = ""
+			m.PendingCopy = ""
+		}
+		return m, nil
+
+	// --- 6. DATA FETCHING ---
 	case config.DataMsg:
 		m = updateModelWithData(m, msg)
-		newCmds := utils.GenerateImagesCmds(msg.Type, msg.Data)
-		cmds = append(cmds, newCmds...)
+		cmds = append(cmds, m.generateImages(msg.Type)...)
 
 	case config.AllMessages:
 		for _, dataMsg := range msg {
 			m = updateModelWithData(m, dataMsg)
-			newCmds := utils.GenerateImagesCmds(dataMsg.Type, dataMsg.Data)
-			cmds = append(cmds, newCmds...)
+			cmds = append(cmds, m.generateImages(dataMsg.Type)...)
 		}
 		m.Loading = false
 		m.refreshViewport()
 
-	// --- 6. WINDOW RESIZE ---
+	// Clicks (the wheel goes on to the viewport below)
+	case tea.MouseMsg:
+		cmds = append(cmds, m.handleMouse(msg))
+
+	// --- 7. WINDOW RESIZE ---
 	case tea.WindowSizeMsg:
 		m.Width = msg.Width
 		m.Height = msg.Height
-		headerHeight := 5
-		footerHeight := 5
+		// (Header and footer shrink on small terminals)
+		headerHeight := lipgloss.Height(m.renderHeader())
+		footerHeight := lipgloss.Height(m.renderFooter())
 		verticalMargin := headerHeight + footerHeight
 		viewPortHeight := m.Height - verticalMargin
 		if viewPortHeight < 5 {
 			viewPortHeight = 5
 		}
 
-		contentWidth := int(float64(m.Width) * 0.8)
-		if contentWidth < 40 {
-			contentWidth = 40
-		}
+		contentWidth := m.contentWidth()
 
+		oldWidth := m.Viewport.Width
 		m.Viewport = viewport.New(contentWidth, viewPortHeight)
+		m.Viewport.KeyMap = m.Keys.ViewportKeys()
 		m.Viewport.YPosition = headerHeight
 		m.Viewport.SetContent(m.generateConetnt(contentWidth))
 
-	// --- 7. IMAGE GENERATION RESULT ---
-	case utils.AsciiIamge:
-		var shouldRefresh bool
-		switch msg.CollectionName {
-		case "projects":
-			if msg.Index < len(m.Projects) {
-				m.Projects[msg.Index]["ascii_art"] = msg.Art
-				if m.ActiveTab == 0 || m.ActiveTab == 1 {
-					shouldRefresh = true
-				}
-			}
-		case "positions":
-			if msg.Index < len(m.Experience) {
-				m.Experience[msg.Index]["ascii_art"] = msg.Art
-				if m.ActiveTab == 2 {
-					shouldRefresh = true
-				}
-			}
-		case "blogs":
-			if msg.Index < len(m.Blogs) {
-				m.Blogs[msg.Index]["ascii_art"] = msg.Art
-				if m.ActiveTab == 0 || m.ActiveTab == 4 {
-					shouldRefresh = true
-				}
+		// Card images follow the card sizes: right away on the first
+		// resize, otherwise once the terminal stops changing size
+		m.ResizeSeq++
+		if oldWidth == 0 {
+			cmds = append(cmds, m.generateImages()...)
+		} else if m.imageSizesChanged(oldWidth) || m.GalleryOpen || m.ProjectDetail {
+			seq := m.ResizeSeq
+			cmds = append(cmds, tea.Tick(resizeDebounce, func(time.Time) tea.Msg {
+				return config.ResizeSettledMsg{Seq: seq}
+			}))
+		}
+
+	case config.ResizeSettledMsg:
+		if msg.Seq == m.ResizeSeq {
+			m.clearArt("gallery")
+			cmds = append(cmds, m.generateImages()...)
+			cmds = append(cmds, m.generateGalleryImages()...)
+			cmds = append(cmds, m.generateDetailImages()...)
+			if m.ProjectDetail && !m.GalleryOpen {
+				cmds = append(cmds, m.loadDemo())
 			}
 		}
-		if shouldRefresh {
-			m.refreshViewport()
+
+	// --- ANIMATED GIFS ---
+	case utils.AnimationMsg:
+		if m.isCurrentDemo(msg) {
+			m.Demo = msg
+			m.DemoFrame = 0
+			cmds = append(cmds, m.playDemo())
+			m.redrawViewport()
+		}
+
+	case config.DemoTickMsg:
+		cmds = append(cmds, m.nextDemoFrame(msg))
+		if m.ProjectDetail && !m.GalleryOpen {
+			m.redrawViewport()
+		}
+
+	// --- 8. IMAGE GENERATION RESULT ---
+	case utils.AsciiIamge:
+		// Results from before an image style switch or a resize are stale
+		if !m.isCurrentArt(msg) {
+			break
+		}
+
+		// Failed downloads get a placeholder instead of art
+		slot := artSlot(msg.CollectionName, msg.Index)
+		delete(m.Failed, slot)
+		if msg.Err != nil {
+			m.Failed[slot] = [2]int{msg.Width, min(msg.Height, 5)}
+			msg.Art = m.imagePlaceholder(msg.Width, min(msg.Height, 5))
+		}
+		m.Art[slot] = msg.Art
+
+		if (m.showsCollection(msg.CollectionName) || msg.CollectionName == "detail") && !m.GalleryOpen {
+			m.redrawViewport()
 		}
 	}
 
-	// --- 8. UPDATE BUBBLES ---
+	// --- 9. UPDATE BUBBLES ---
 	m.Viewport, cmd = m.Viewport.Update(msg)
 	cmds = append(cmds, cmd)
 	m.Spinner, cmd = m.Spinner.Update(msg)
@@ -279,17 +416,17 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	// Inputs (Only update if on Contact Page)
 	if m.ActiveTab == 5 {
-		m.FirstNameInput, cmd = m.FirstNameInput.Update(msg)
-		cmds = append(cmds, cmd)
-		m.LastNameInput, cmd = m.LastNameInput.Update(msg)
-		cmds = append(cmds, cmd)
-		m.EmailInput, cmd = m.EmailInput.Update(msg)
-		cmds = append(cmds, cmd)
-		m.MsgInput, cmd = m.MsgInput.Update(msg)
-		cmds = append(cmds, cmd)
-
-		// Live View Update: Optimized to only render when actually typing
-		if keyMsg, ok := msg.(tea.KeyMsg); ok && !m.ContactLoading && isTypingInput(keyMsg) {
+		typing := !m.ContactLoading && m.isTypingInForm()
+		var before string
+		if typing {
+			before = m.Form[m.FocusIndex].text()
+		}
+		cmds = append(cmds, m.updateFormInputs(msg))
+
+		// Live View Update: only render when the text changed (typed, pasted, deleted a word...)
+		if typing && m.Form[m.FocusIndex].text() != before {
+			m.autosaveDraft()
+			delete(m.FormErrors, m.Form[m.FocusIndex].Field.Name) // Clear the error once they start fixing it
 			m.Viewport.SetContent(m.renderContactSection(m.Viewport.Width))
 		}
 	}
@@ -302,23 +439,21 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 // updateFocus handles blurring/focusing inputs based on m.FocusIndex
 func (m *Model) updateFocus() tea.Cmd {
 	// 1. Blur all
-	m.FirstNameInput.Blur()
-	m.LastNameInput.Blur()
-	m.EmailInput.Blur()
-	m.MsgInput.Blur()
+	for i := range m.Form {
+		m.Form[i].Text.Blur()
+		m.Form[i].Area.Blur()
+	}
 
 	var cmd tea.Cmd
 
 	// 2. Focus specific
-	switch m.FocusIndex {
-	case 0:
-		cmd = m.FirstNameInput.Focus()
-	case 1:
-		cmd = m.LastNameInput.Focus()
-	case 2:
-		cmd = m.EmailInput.Focus()
-	case 5:
-		cmd = m.MsgInput.Focus()
+	if in := m.focusedInput(); in != nil {
+		switch in.Field.Type {
+		case config.FieldText, config.FieldEmail:
+			cmd = in.Text.Focus()
+		case config.FieldTextarea:
+			cmd = in.Area.Focus()
+		}
 	}
 
 	// 3. Refresh view to update border colors
@@ -327,8 +462,44 @@ func (m *Model) updateFocus() tea.Cmd {
 	return cmd
 }
 
+// redrawViewport regenerates the current tab's content, keeping the
+// scroll position (e.g. when an image arrives)
+func (m *Model) redrawViewport() {
+	if m.ActiveTab == 5 {
+		m.Viewport.SetContent(m.renderContactSection(m.Viewport.Width))
+	} else {
+		m.Viewport.SetContent(m.generateConetnt(m.Viewport.Width))
+	}
+}
+
+// goToTab switches tabs, closing any page open on the current one
+func (m *Model) goToTab(tab int) {
+	m.ActiveTab = tab
+	m.ProjectDetail = false
+	m.BlogReader = false
+	m.clearArt("detail")
+	m.stopDemo()
+	m.refreshViewport()
+}
+
 // refreshViewport regenerates the current tab's content
 func (m *Model) refreshViewport() {
+	// Leaving the Projects tab closes a project's page
+	if m.ActiveTab != 1 && m.ProjectDetail {
+		m.ProjectDetail = false
+		m.clearArt("detail")
+		m.stopDemo()
+	}
+	if m.ActiveTab != 1 {
+		m.TagMode = false
+	}
+	// Same for an open article on the Blogs tab
+	if m.ActiveTab != 4 {
+		m.BlogReader = false
+	}
+	// This tab's pictures go before the others still waiting
+	m.prioritizeImages()
+
 	// If on Contact page (5), use the special render function
 	// Otherwise use the generic generator
 	if m.ActiveTab == 5 {
@@ -340,22 +511,26 @@ func (m *Model) refreshViewport() {
 	m.Viewport.GotoTop()
 }
 
-func isTypingInput(msg tea.KeyMsg) bool {
-	// Simple check if the key is likely a printable character
-	return len(msg.String()) == 1 || msg.String() == "backspace" || msg.String() == "space"
-}
-
 // Helper function to keep the switch clean
 func updateModelWithData(m Model, msg config.DataMsg) Model {
+	m.clearArt(msg.Type)
+
 	switch msg.Type {
 	case "projects":
 		m.Projects = msg.Data
+		m.pruneTagFilter()
 	case "positions":
 		m.Experience = msg.Data
 	case "services":
 		m.Services = msg.Data
 	case "blogs":
 		m.Blogs = msg.Data
+	case "forms":
+		if fields, ok := config.ParseFormDefinition(msg.Data, config.ContactFormName); ok {
+			if fields = checkFormFields(fields); len(fields) > 0 {
+				m.setFormFields(fields)
+			}
+		}
 	}
 	return m
 }
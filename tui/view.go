@@ -3,122 +3,98 @@ package tui
 import (
 	"fmt"
 	"portfolioTUI/utils"
+	"slices"
 	"strings"
 
+	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/lipgloss"
 )
 
-// Styles
-var (
-	highlight = lipgloss.NewStyle().Foreground(lipgloss.Color("205")).Bold(true)
-	subtle    = lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
-
-	// Navigation Bar Styles
-	activeTabBorder = lipgloss.Border{
-		Top: "─", Bottom: " ", Left: "│", Right: "│",
-		TopLeft: "╭", TopRight: "╮", BottomLeft: "┘", BottomRight: "└",
-	}
-	tabStyle       = lipgloss.NewStyle().Padding(0, 1).Border(lipgloss.NormalBorder(), true)
-	activeTabStyle = tabStyle.Copy().Border(activeTabBorder, true).Foreground(lipgloss.Color("63"))
-)
+// Navigation Bar Border
+var activeTabBorder = lipgloss.Border{
+	Top: "─", Bottom: " ", Left: "│", Right: "│",
+	TopLeft: "╭", TopRight: "╮", BottomLeft: "┘", BottomRight: "└",
+}
 
 func (m Model) generateConetnt(width int) string {
-
-	// 1. Calculate Content Dimensions (e.g., 80% of screen width)
-	contentWidth := int(float64(m.Width) * 0.8)
-	if contentWidth < 40 {
-		contentWidth = 40 // Safe minimum width
-	}
-	doc := strings.Builder{}
-
-	switch m.ActiveTab {
-	case 0:
-		return m.renderHome(width)
-
-	case 1: // Projects
-		return m.renderProject(width)
-
-	case 2:
-		return m.renderPosition(contentWidth)
-
-	case 3: // Servicese
-		return m.renderServices(width, false)
-
-	case 4:
-		return m.renderBlogsSection(width, false)
-
-	case 5: // Contact
-		return m.renderContactSection(width)
-	}
-
-	return doc.String()
-
+	content, _ := m.contentLayout(width)
+	return content
 }
 
 func (m Model) View() string {
 	// 1. Loading Screen
 	if m.Loading {
-		return lipgloss.Place(
+		return m.Renderer.Place(
 			m.Width, m.Height,
 			lipgloss.Center, lipgloss.Center,
 			fmt.Sprintf("%s Loading Data...", m.Spinner.View()),
 		)
 	}
 
-	// 2. BUILD HEADER (Logo + Gap + Tabs)
-	// Logo Style
-	logoStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("205")).
-		Background(lipgloss.Color("235")).
-		Bold(true).
-		Padding(0, 1).
-		MarginRight(1).
-		MarginLeft(3).
-		MarginTop(2).
-		SetString("TARUN NAYAKA R")
-
-	logo := logoStyle.Render()
-
-	// Tabs Style
-	tabs := []string{"  Home (H)", "  Projects (P)", "  Experience (E)", " Services (S)", "󰆉 Blogs (B)", "  Contact (C)"}
-	var renderedTabs []string
-
-	for i, t := range tabs {
-		if m.ActiveTab == i {
-			renderedTabs = append(renderedTabs, activeTabStyle.Render(t))
-		} else {
-			renderedTabs = append(renderedTabs, tabStyle.Render(t))
-		}
+	// Nothing fits, say so instead of drawing a broken layout
+	if m.tooSmall() {
+		return m.renderTooSmall()
 	}
-	tabsBlock := lipgloss.JoinHorizontal(lipgloss.Top, renderedTabs...)
 
-	// Calculate Gap
-	gapWidth := m.Width - lipgloss.Width(logo) - lipgloss.Width(tabsBlock) - 4 // -4 for extra safety margin
-	if gapWidth < 0 {
-		gapWidth = 0
+	// Overlays replace the whole layout
+	if m.HelpOpen {
+		return m.renderHelp()
+	}
+	if m.PaletteOpen {
+		return m.renderPalette()
+	}
+	if m.GalleryOpen {
+		return m.renderGallery()
+	}
+	if m.SearchOpen {
+		return m.renderSearch()
 	}
-	gap := strings.Repeat(" ", gapWidth)
 
-	// Combine into Header
-	header := lipgloss.JoinHorizontal(lipgloss.Top, logo, gap, tabsBlock)
-	// Add some padding below the header
-	header = lipgloss.NewStyle().MarginBottom(1).Render(header)
+	// 2. BUILD HEADER (Logo + Gap + Tabs)
+	header := m.renderHeader()
 
 	// 3. BUILD VIEWPORT (Content)
-	viewportContent := lipgloss.NewStyle().
+	viewportContent := m.Renderer.NewStyle().
 		Width(m.Width).
 		Align(lipgloss.Center).
-		Render(m.Viewport.View())
+		Render(utils.ClipSixel(m.Viewport.View())) // Sixels cut off at the top would draw over the header
+
+	// 4. BUILD FOOTER
+	footer := m.renderFooter()
+
+	//  STACK EVERYTHING VERTICALLY
+	return lipgloss.JoinVertical(lipgloss.Top,
+		header,
+		viewportContent,
+		footer,
+	)
+}
+
+// renderFooter is the keys that work here ("?" shows them all) and the
+// social links, when there's room for them
+func (m Model) renderFooter() string {
+	helpText := m.footerHelp()
+
+	// Toasts replace the help line for a few seconds
+	if m.Toast != "" {
+		helpText = m.Renderer.NewStyle().
+			Foreground(m.Theme.Success).
+			Bold(true).
+			Render(m.Toast) + m.PendingCopy
+	}
 
-		// 4. BUILD FOOTER (Help Text)
-	helpText := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#626262")).
-		Render("use ← → or Tab to navigate • j/k to scroll • q to quit")
+	footerStyle := m.Renderer.NewStyle().
+		Width(m.Width).
+		Align(lipgloss.Center).
+		PaddingTop(1) // Space between content and footer
+	if m.compactFooter() {
+		return footerStyle.Render(helpText)
+	}
 
 	//  Social Links
-	ghIcon := highlight.Render("  GitHub")
-	liIcon := highlight.Render("  LinkedIn")
-	webIcon := highlight.Render("  Portfolio") // or use  for Desktop
+	ghIcon := m.Styles.Highlight.Render("  GitHub")
+	liIcon := m.Styles.Highlight.Render("  LinkedIn")
+	webIcon := m.Styles.Highlight.Render("  Portfolio") // or use  for Desktop
 
 	github := utils.MakeLink(ghIcon, "https://github.com/tarunNayaka")
 	linkedin := utils.MakeLink(liIcon, "https://linkedin.com/in/tarun")
@@ -131,16 +107,110 @@ func (m Model) View() string {
 	footerContent := lipgloss.JoinVertical(lipgloss.Center, helpText, " \n", socials)
 
 	//  Render the full footer container
-	footer := lipgloss.NewStyle().
-		Width(m.Width).
-		Align(lipgloss.Center).
-		PaddingTop(1). // Space between content and footer
-		Render(footerContent)
+	return footerStyle.Render(footerContent)
+}
 
-	//  STACK EVERYTHING VERTICALLY
-	return lipgloss.JoinVertical(lipgloss.Top,
-		header,
-		viewportContent,
-		footer,
+// renderTooSmall replaces everything when the terminal is below the minimum
+func (m Model) renderTooSmall() string {
+	msg := lipgloss.JoinVertical(lipgloss.Center,
+		m.Styles.Title.Render("Terminal too small"),
+		m.Styles.Subtle.Render(fmt.Sprintf("%d×%d, needs %d×%d", m.Width, m.Height, minWidth, minHeight)),
+		m.Styles.Subtle.Render(m.Keys.Global.Quit.Help().Key+" quit"),
 	)
+	return m.Renderer.Place(m.Width, m.Height, lipgloss.Center, lipgloss.Center, msg)
+}
+
+// headerParts renders the logo and each tab, the gap between them, and
+// the tab a click on each part goes to
+func (m Model) headerParts() (logo string, gapWidth int, tabs []string, targets []int) {
+	// Logo Style
+	logoStyle := m.Renderer.NewStyle().
+		Foreground(m.Theme.Accent).
+		Background(m.Theme.Surface).
+		Bold(true).
+		Padding(0, 1).
+		MarginRight(1).
+		MarginLeft(3).
+		MarginTop(2).
+		SetString("TARUN NAYAKA R")
+
+	logo = logoStyle.Render()
+
+	// Tabs Style
+	// (with their hotkeys, whatever the key map says)
+	k := m.Keys.Global
+	names := []string{"  Home", "  Projects", "  Experience", " Services", "󰆉 Blogs", "  Contact"}
+	withKeys := slices.Clone(names)
+	for i, b := range []key.Binding{k.Home, k.Projects, k.Experience, k.Services, k.Blogs, k.Contact} {
+		if b.Enabled() {
+			withKeys[i] += " (" + b.Help().Key + ")"
+		}
+	}
+
+	// Hotkeys are the first thing to go when the tabs don't fit
+	for _, labels := range [][]string{withKeys, names} {
+		tabs, targets = nil, nil
+		tabsWidth := 0
+		for i, t := range labels {
+			if m.ActiveTab == i {
+				tabs = append(tabs, m.Styles.ActiveTab.Render(t))
+			} else {
+				tabs = append(tabs, m.Styles.Tab.Render(t))
+			}
+			tabsWidth += lipgloss.Width(tabs[i])
+			targets = append(targets, i)
+		}
+
+		// Calculate Gap
+		if gapWidth = m.Width - lipgloss.Width(logo) - tabsWidth - 4; gapWidth >= 0 { // -4 for extra safety margin
+			return logo, gapWidth, tabs, targets
+		}
+	}
+
+	// Too narrow for the tab bar: it collapses into "‹  Projects (P) 2/6 ›",
+	// on one line with the logo (if that still fits)
+	prev, next := "   ", "   "
+	if m.ActiveTab > 0 {
+		prev = " ‹ "
+	}
+	if m.ActiveTab < len(names)-1 {
+		next = " › "
+	}
+	tabs = []string{
+		m.Styles.Subtle.Render(prev),
+		m.Styles.Highlight.Render(withKeys[m.ActiveTab]) + m.Styles.Subtle.Render(fmt.Sprintf(" %d/%d", m.ActiveTab+1, len(names))),
+		m.Styles.Subtle.Render(next),
+	}
+	targets = []int{max(m.ActiveTab-1, 0), m.ActiveTab, min(m.ActiveTab+1, len(names)-1)}
+	tabsWidth := lipgloss.Width(strings.Join(tabs, ""))
+
+	logo = logoStyle.MarginTop(0).MarginLeft(1).Render()
+	if lipgloss.Width(logo)+tabsWidth+1 > m.Width {
+		logo = ""
+	}
+	return logo, max(m.Width-lipgloss.Width(logo)-tabsWidth-1, 0), tabs, targets
+}
+
+// renderHeader draws the logo and the tab bar
+func (m Model) renderHeader() string {
+	logo, gapWidth, tabs, _ := m.headerParts()
+	tabsBlock := lipgloss.JoinHorizontal(lipgloss.Top, tabs...)
+
+	// Combine into Header
+	header := lipgloss.JoinHorizontal(lipgloss.Top, logo, strings.Repeat(" ", gapWidth), tabsBlock)
+	// Add some padding below the header
+	return m.Renderer.NewStyle().MarginBottom(1).Render(header)
+}
+
+// tabAt returns the tab under a cell of the screen, if any
+func (m Model) tabAt(x, y int) (int, bool) {
+	logo, gapWidth, tabs, targets := m.headerParts()
+	left := lipgloss.Width(logo) + gapWidth
+	for i, t := range tabs {
+		if y < lipgloss.Height(t) && x >= left && x < left+lipgloss.Width(t) {
+			return targets[i], true
+		}
+		left += lipgloss.Width(t)
+	}
+	return 0, false
 }
@@ -1,52 +1,139 @@
 package utils
 
 import (
-	"image"
+	"fmt"
 	"log"
-	"net/http"
+	"portfolioTUI/config"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
-	"github.com/qeesung/image2ascii/convert"
 )
 
 type AsciiIamge struct {
 	CollectionName string
 	Index          int
 	Art            string
+	Mode           ImageMode // Mode the art was requested in
+	Width, Height  int       // Size the art was requested at
+	Source         string    // Image URL
+	Err            error     // Set when the image couldn't be fetched or decoded
 }
 
-func GenerateAsciiImage(url string, collectionName string, index int, width int, height int) tea.Cmd {
+// GenerateAsciiImage renders one card image. Downloads go through the
+// shared worker pool; priority decides which queued images go first.
+func GenerateAsciiImage(collectionName string, index int, opts ImageOptions, priority int) tea.Cmd {
+	url := opts.Source
 
-	return func() tea.Msg {
-		res, err := http.Get(url)
-		if err != nil {
-			log.Fatal("error generating image response from get ", collectionName, index, url, err)
-			return nil
-		}
-		defer res.Body.Close()
+	return func() (msg tea.Msg) {
+		// A decoder panic on a malformed file must not crash the server either
+		defer func() {
+			if r := recover(); r != nil {
+				log.Println("panic generating image", collectionName, index, url, r)
+				msg = AsciiIamge{
+					CollectionName: collectionName,
+					Index:          index,
+					Mode:           opts.Mode,
+					Width:          opts.Width,
+					Height:         opts.Height,
+					Source:         opts.Source,
+					Err:            fmt.Errorf("panic: %v", r),
+				}
+			}
+		}()
 
-		img, _, err := image.Decode(res.Body)
+		// Fresh cache hits don't need to wait for a worker
+		asciiArt, ok := freshArt(opts)
+		var err error
+		if !ok {
+			asciiArt, err = runImageJob(opts, priority)
+		}
 		if err != nil {
-			log.Fatal("error generating image decode ", collectionName, index, url, err)
-			return nil
+			// Never take the server down for one broken image:
+			// report it so the card can show a placeholder
+			log.Println("error generating image", collectionName, index, url, err)
+			return AsciiIamge{
+				CollectionName: collectionName,
+				Index:          index,
+				Mode:           opts.Mode,
+				Width:          opts.Width,
+				Height:         opts.Height,
+				Source:         opts.Source,
+				Err:            err,
+			}
 		}
 
-		convertOptions := convert.DefaultOptions
-		convertOptions.FixedWidth = width
-		convertOptions.FixedHeight = height
-		convertOptions.Colored = true
-		convertOptions.Ratio = 0.5 // Best for thumbnails
-
-		converter := convert.NewImageConverter()
-		asciiArt := converter.Image2ASCIIString(img, &convertOptions)
-
 		// D. Return with Collection Name
 		return AsciiIamge{
 			CollectionName: collectionName,
 			Index:          index,
 			Art:            asciiArt,
+			Mode:           opts.Mode,
+			Width:          opts.Width,
+			Height:         opts.Height,
+			Source:         opts.Source,
 		}
 
 	}
 
 }
+
+// cachedArt returns the art for an image, only downloading and converting
+// when the shared cache has nothing fresh for this URL, size and render mode
+func cachedArt(opts ImageOptions) (string, error) {
+	mode := opts.cacheMode()
+	key := artKey(opts.Source, opts.Width, opts.Height, mode)
+
+	// A. Fresh cache hit: no HTTP at all
+	cached, found := lookupArt(key)
+	if found && time.Since(cached.FetchedAt) < artRevalidate {
+		return cached.Art, nil
+	}
+
+	// B. Download the source (shared with every other size of it) and fingerprint it
+	src, err := loadSource(opts.Source)
+	if err != nil {
+		return "", err
+	}
+	hash := src.Hash
+	hkey := hashKey(hash, opts.Width, opts.Height, mode)
+
+	// C. Unchanged content (or the same image under another URL): reuse the art
+	if found && cached.Hash == hash {
+		storeArt(key, hkey, artEntry{Art: cached.Art, Hash: hash, FetchedAt: time.Now()})
+		return cached.Art, nil
+	}
+	if art, ok := lookupArtByHash(hkey); ok {
+		storeArt(key, hkey, artEntry{Art: art, Hash: hash, FetchedAt: time.Now()})
+		return art, nil
+	}
+
+	// D. New content: decode and convert
+	var art string
+	img, err := src.decoded()
+	if err == nil {
+		art = renderImage(img, opts)
+	} else {
+		// Unsupported or broken file: show the default image instead
+		if opts.Source == config.DEFAULTIMAGEURL {
+			return "", fmt.Errorf("decode: %w", err)
+		}
+		log.Println("can't decode image, using the default", opts.Source, err)
+		fallback := opts
+		fallback.Source = config.DEFAULTIMAGEURL
+		if art, err = cachedArt(fallback); err != nil {
+			return "", err
+		}
+	}
+
+	storeArt(key, hkey, artEntry{Art: art, Hash: hash, FetchedAt: time.Now()})
+	return art, nil
+}
+
+// freshArt returns cached art that doesn't need revalidating yet
+func freshArt(opts ImageOptions) (string, bool) {
+	cached, found := lookupArt(artKey(opts.Source, opts.Width, opts.Height, opts.cacheMode()))
+	if found && time.Since(cached.FetchedAt) < artRevalidate {
+		return cached.Art, true
+	}
+	return "", false
+}
@@ -0,0 +1,198 @@
+package utils
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/gif"
+	"log"
+	"sync"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/muesli/termenv"
+)
+
+// Animation limits: frames are sent to the visitor in full every time, so
+// both the frame rate and the size are capped
+const (
+	minFrameDelay   = 100 * time.Millisecond // At most 10 frames per second
+	maxFrames       = 150                    // Longer GIFs skip frames
+	maxGIFPixels    = 1 << 20                // Bigger canvases (about 1024x1024) aren't animated
+	maxAnimWidth    = 80
+	maxAnimHeight   = 30
+	defaultGIFDelay = 100 * time.Millisecond // For GIFs that ask for "as fast as possible"
+)
+
+// AnimationMsg carries the rendered frames of an animated GIF
+type AnimationMsg struct {
+	Source        string
+	Mode          ImageMode // Mode the frames were rendered in
+	Width, Height int       // Size the frames were requested at
+	Frames        []string
+	Delays        []time.Duration // How long each frame stays up
+	Err           error
+}
+
+// animEntry is a cached frame sequence
+type animEntry struct {
+	Frames    []string
+	Delays    []time.Duration
+	FetchedAt time.Time
+}
+
+// Global Animation Cache (memory only, frames are large)
+var (
+	animCache = map[string]animEntry{}
+	animMutex sync.Mutex
+)
+
+// AnimationOptions adapts render options for animations: bitmaps are too
+// heavy to resend on every frame, so they become half blocks (or ASCII on
+// terminals without colors), and the size is capped
+func AnimationOptions(o ImageOptions) ImageOptions {
+	switch {
+	case o.Profile == termenv.Ascii:
+		o.Mode = ModeASCII
+	case o.Mode == ModeSixel || o.Mode == ModeKitty:
+		o.Mode = ModeHalfBlock
+	}
+	o.Width = min(o.Width, maxAnimWidth)
+	o.Height = min(o.Height, maxAnimHeight)
+	return o
+}
+
+// GenerateAnimation decodes an animated GIF into rendered frames on the
+// shared worker pool. Pass options through AnimationOptions first.
+func GenerateAnimation(opts ImageOptions, priority int) tea.Cmd {
+	return func() tea.Msg {
+		msg := AnimationMsg{
+			Source: opts.Source,
+			Mode:   opts.Mode,
+			Width:  opts.Width,
+			Height: opts.Height,
+		}
+
+		key := "anim|" + artKey(opts.Source, opts.Width, opts.Height, opts.cacheMode())
+		result, err := runPooled(key, opts.Source, priority, func() (any, error) {
+			return animatedArt(key, opts)
+		})
+		if err != nil {
+			log.Println("error generating animation", opts.Source, err)
+			msg.Err = err
+			return msg
+		}
+
+		entry := result.(animEntry)
+		msg.Frames, msg.Delays = entry.Frames, entry.Delays
+		return msg
+	}
+}
+
+// animatedArt returns the frames for a GIF, from the cache when fresh.
+// Files that aren't GIFs become a single still frame.
+func animatedArt(key string, opts ImageOptions) (animEntry, error) {
+	animMutex.Lock()
+	cached, ok := animCache[key]
+	animMutex.Unlock()
+	if ok && time.Since(cached.FetchedAt) < artRevalidate {
+		return cached, nil
+	}
+
+	src, err := loadSource(opts.Source)
+	if err != nil {
+		return animEntry{}, err
+	}
+
+	// Check the canvas size before decoding every frame of it
+	if cfg, err := gif.DecodeConfig(bytes.NewReader(src.Data)); err == nil && cfg.Width*cfg.Height > maxGIFPixels {
+		return animEntry{}, fmt.Errorf("gif is %dx%d, too large to animate", cfg.Width, cfg.Height)
+	}
+
+	var entry animEntry
+	if g, err := gif.DecodeAll(bytes.NewReader(src.Data)); err == nil && len(g.Image) > 0 {
+		entry.Frames, entry.Delays, err = composeGIF(g, func(img image.Image) string { return renderImage(img, opts) })
+		if err != nil {
+			return animEntry{}, err
+		}
+	} else {
+		img, err := src.decoded()
+		if err != nil {
+			return animEntry{}, fmt.Errorf("decode: %w", err)
+		}
+		entry.Frames = []string{renderImage(img, opts)}
+		entry.Delays = []time.Duration{0}
+	}
+	entry.FetchedAt = time.Now()
+
+	animMutex.Lock()
+	animCache[key] = entry
+	// Prune old animations so the map doesn't grow forever
+	for k, e := range animCache {
+		if time.Since(e.FetchedAt) > artRevalidate {
+			delete(animCache, k)
+		}
+	}
+	animMutex.Unlock()
+	return entry, nil
+}
+
+// composeGIF turns GIF frames (which may only hold the changed part of the
+// picture) into full images, honoring each frame's disposal method, and
+// renders each one as it goes so only one canvas is ever in memory. Delays
+// are clamped to the frame rate limit and long GIFs drop frames, adding
+// the skipped time to the frames that are kept.
+func composeGIF(g *gif.GIF, render func(image.Image) string) ([]string, []time.Duration, error) {
+	bounds := image.Rect(0, 0, g.Config.Width, g.Config.Height)
+	if bounds.Empty() {
+		bounds = g.Image[0].Bounds()
+	}
+	if bounds.Dx()*bounds.Dy() > maxGIFPixels {
+		return nil, nil, fmt.Errorf("gif is %dx%d, too large to animate", bounds.Dx(), bounds.Dy())
+	}
+	canvas := image.NewRGBA(bounds)
+	step := (len(g.Image) + maxFrames - 1) / maxFrames
+
+	var (
+		frames []string
+		delays []time.Duration
+	)
+	for i, frame := range g.Image {
+		var disposal byte
+		if i < len(g.Disposal) {
+			disposal = g.Disposal[i]
+		}
+		var previous *image.RGBA
+		if disposal == gif.DisposalPrevious {
+			previous = image.NewRGBA(bounds)
+			draw.Draw(previous, bounds, canvas, bounds.Min, draw.Src)
+		}
+
+		draw.Draw(canvas, frame.Bounds(), frame, frame.Bounds().Min, draw.Over)
+
+		delay := defaultGIFDelay
+		if i < len(g.Delay) && g.Delay[i] > 1 {
+			delay = time.Duration(g.Delay[i]) * 10 * time.Millisecond
+		}
+
+		if i%step == 0 {
+			frames = append(frames, render(canvas))
+			delays = append(delays, delay)
+		} else {
+			delays[len(delays)-1] += delay
+		}
+
+		switch disposal {
+		case gif.DisposalBackground:
+			draw.Draw(canvas, frame.Bounds(), image.Transparent, image.Point{}, draw.Src)
+		case gif.DisposalPrevious:
+			canvas = previous
+		}
+	}
+
+	for i := range delays {
+		delays[i] = max(delays[i], minFrameDelay)
+	}
+	return frames, delays, nil
+}
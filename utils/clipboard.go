@@ -0,0 +1,52 @@
+package utils
+
+import (
+	"strings"
+
+	"github.com/aymanbagabas/go-osc52/v2"
+)
+
+// Terminals known to set the clipboard through OSC 52, by TERM and by
+// TERM_PROGRAM / LC_TERMINAL (when the visitor's SSH client forwards them).
+// Anything else (VTE terminals, xterm without allowWindowOps...) may
+// silently ignore it, so those visitors get the text on screen instead.
+var (
+	clipboardTerms    = []string{"xterm-kitty", "xterm-ghostty", "ghostty", "alacritty", "foot", "foot-extra", "wezterm", "contour", "rio"}
+	clipboardPrograms = []string{"iterm.app", "iterm2", "wezterm", "ghostty", "kitty", "rio"}
+)
+
+// SupportsOSC52 tells from TERM and the forwarded environment whether the
+// visitor's terminal is one known to set its clipboard through OSC 52
+func SupportsOSC52(term string, environ []string) bool {
+	term = strings.ToLower(term)
+	for _, t := range clipboardTerms {
+		if term == t {
+			return true
+		}
+	}
+	for _, kv := range environ {
+		key, val, _ := strings.Cut(kv, "=")
+		if key != "TERM_PROGRAM" && key != "LC_TERMINAL" {
+			continue
+		}
+		for _, p := range clipboardPrograms {
+			if strings.EqualFold(val, p) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// CopySequence builds the OSC 52 escape that copies text to the visitor's
+// local clipboard, wrapped for tmux/screen when the visitor is inside one
+func CopySequence(text, term string) string {
+	seq := osc52.New(text)
+	switch {
+	case strings.HasPrefix(term, "tmux"):
+		seq = seq.Tmux()
+	case strings.HasPrefix(term, "screen"):
+		seq = seq.Screen()
+	}
+	return seq.String()
+}
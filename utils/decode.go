@@ -0,0 +1,62 @@
+package utils
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/draw"
+
+	// Formats understood by image.Decode (GIF decodes its first frame)
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+
+	_ "golang.org/x/image/webp"
+
+	"github.com/srwiley/oksvg"
+	"github.com/srwiley/rasterx"
+)
+
+// Longest side, in pixels, SVGs are rasterized at
+const svgRasterSize = 512
+
+// decodeImage turns downloaded bytes into an image: PNG, JPEG, GIF, WebP,
+// or SVG (rasterized)
+func decodeImage(data []byte) (image.Image, error) {
+	if isSVG(data) {
+		return rasterizeSVG(data)
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	return img, err
+}
+
+// isSVG sniffs for an SVG document (servers often send it as text/xml)
+func isSVG(data []byte) bool {
+	head := data[:min(len(data), 1024)]
+	head = bytes.TrimLeft(bytes.TrimPrefix(head, []byte("\xef\xbb\xbf")), " \t\r\n")
+	if !bytes.HasPrefix(head, []byte("<")) {
+		return false
+	}
+	return bytes.Contains(head, []byte("<svg"))
+}
+
+// rasterizeSVG draws an SVG on a transparent canvas, keeping its aspect ratio
+func rasterizeSVG(data []byte) (image.Image, error) {
+	icon, err := oksvg.ReadIconStream(bytes.NewReader(data), oksvg.IgnoreErrorMode)
+	if err != nil {
+		return nil, fmt.Errorf("svg: %w", err)
+	}
+
+	w, h := icon.ViewBox.W, icon.ViewBox.H
+	if w <= 0 || h <= 0 {
+		w, h = svgRasterSize, svgRasterSize
+	}
+	scale := svgRasterSize / max(w, h)
+	pw, ph := max(int(w*scale), 1), max(int(h*scale), 1)
+
+	img := image.NewRGBA(image.Rect(0, 0, pw, ph))
+	draw.Draw(img, img.Bounds(), image.Transparent, image.Point{}, draw.Src)
+	icon.SetTarget(0, 0, float64(pw), float64(ph))
+	icon.Draw(rasterx.NewDasher(pw, ph, rasterx.NewScannerGV(pw, ph, img, img.Bounds())), 1)
+	return img, nil
+}
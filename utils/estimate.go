@@ -0,0 +1,112 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+var (
+	numberPattern    = regexp.MustCompile(`\d[\d,]*(\.\d+)?`)
+	timeframePattern = regexp.MustCompile(`(\d+)\s*(?:-|–|to)?\s*(\d+)?\s*(day|week|month)`)
+	currencyPattern  = regexp.MustCompile(`^(?:[A-Za-z]{0,3}\.?\p{Sc}+|[A-Z]{3}|Rs\.?)$`) // "$", "US$", "EUR", "Rs."
+	suffixCurrency   = regexp.MustCompile(`^\+?\s*(\p{Sc}+|[A-Z]{3}\b)`)                  // "200 USD", "150€"
+)
+
+// ParsePrice pulls the first amount out of a price label like "₹4,999+",
+// "Starting at $200" or "150 EUR". It also returns the currency written
+// before or after it.
+func ParsePrice(price string) (amount float64, currency string, ok bool) {
+	loc := numberPattern.FindStringIndex(price)
+	if loc == nil {
+		return 0, "", false
+	}
+
+	amount, err := strconv.ParseFloat(strings.ReplaceAll(price[loc[0]:loc[1]], ",", ""), 64)
+	if err != nil {
+		return 0, "", false
+	}
+
+	// Currency is the token right before the number ("$", "₹", "Rs."),
+	// else right after it ("USD", "€")
+	if fields := strings.Fields(price[:loc[0]]); len(fields) > 0 && currencyPattern.MatchString(fields[len(fields)-1]) {
+		currency = fields[len(fields)-1]
+	} else if match := suffixCurrency.FindStringSubmatch(price[loc[1]:]); match != nil {
+		currency = match[1]
+	}
+	return amount, currency, true
+}
+
+// ParseTimeframe converts labels like "2-4 weeks" or "10 days" into a day range
+func ParseTimeframe(timeframe string) (minDays, maxDays int, ok bool) {
+	match := timeframePattern.FindStringSubmatch(strings.ToLower(timeframe))
+	if match == nil {
+		return 0, 0, false
+	}
+
+	unit := 1
+	switch match[3] {
+	case "week":
+		unit = 7
+	case "month":
+		unit = 30
+	}
+
+	minDays, _ = strconv.Atoi(match[1])
+	maxDays = minDays
+	if match[2] != "" {
+		maxDays, _ = strconv.Atoi(match[2])
+	}
+	return minDays * unit, maxDays * unit, true
+}
+
+// FormatAmount renders 12498 as "12,498" (and keeps decimals when present)
+func FormatAmount(amount float64) string {
+	whole := int64(amount)
+	str := strconv.FormatInt(whole, 10)
+
+	var out strings.Builder
+	for i, r := range str {
+		if i > 0 && (len(str)-i)%3 == 0 {
+			out.WriteRune(',')
+		}
+		out.WriteRune(r)
+	}
+
+	if frac := amount - float64(whole); frac > 0.005 {
+		out.WriteString(fmt.Sprintf("%.2f", frac)[1:])
+	}
+	return out.String()
+}
+
+// FormatPrice puts the currency in front of an amount, with a space after
+// codes ("$200", "USD 200", "Rs. 4,999")
+func FormatPrice(amount float64, currency string) string {
+	if r, _ := utf8.DecodeLastRuneInString(currency); unicode.IsLetter(r) || r == '.' {
+		currency += " "
+	}
+	return currency + FormatAmount(amount)
+}
+
+// FormatDays renders a day range in the largest sensible unit ("3-6 weeks",
+// "1-2 months"), the reverse of ParseTimeframe
+func FormatDays(minDays, maxDays int) string {
+	unit, label := 1, "days"
+	switch {
+	case minDays >= 30 && minDays%30 == 0 && maxDays%30 == 0:
+		unit, label = 30, "months"
+	case minDays >= 7 && minDays%7 == 0 && maxDays%7 == 0:
+		unit, label = 7, "weeks"
+	}
+
+	if minDays == maxDays {
+		if minDays == unit {
+			label = strings.TrimSuffix(label, "s")
+		}
+		return fmt.Sprintf("%d %s", minDays/unit, label)
+	}
+	return fmt.Sprintf("%d-%d %s", minDays/unit, maxDays/unit, label)
+}
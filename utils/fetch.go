@@ -0,0 +1,169 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Image download limits
+var (
+	imageClient   = &http.Client{Timeout: 10 * time.Second}
+	maxImageBytes = int64(10 << 20) // 10 MB
+	imageRetries  = 3
+	retryBackoff  = 500 * time.Millisecond
+)
+
+// errPermanent marks failures that retrying won't fix (404, wrong type, too big)
+var errPermanent = errors.New("permanent")
+
+// fetchImage downloads an image with a timeout, size and content-type checks,
+// retrying transient failures (network errors, 5xx, 429) with backoff
+func fetchImage(url string) ([]byte, error) {
+	var lastErr error
+
+	for attempt := 0; attempt < imageRetries; attempt++ {
+		if attempt > 0 {
+			time.Sleep(retryBackoff << (attempt - 1))
+		}
+
+		data, err := fetchImageOnce(url)
+		if err == nil {
+			return data, nil
+		}
+		lastErr = err
+		if errors.Is(err, errPermanent) {
+			break
+		}
+	}
+
+	return nil, lastErr
+}
+
+// fetchImageOnce performs a single download attempt
+func fetchImageOnce(url string) ([]byte, error) {
+	res, err := imageClient.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	// 1. Status
+	if res.StatusCode == http.StatusTooManyRequests || res.StatusCode >= 500 {
+		return nil, fmt.Errorf("server returned %s", res.Status)
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: server returned %s", errPermanent, res.Status)
+	}
+
+	// 2. Size (when the server tells us up front)
+	if res.ContentLength > maxImageBytes {
+		return nil, fmt.Errorf("%w: image is %d bytes (limit %d)", errPermanent, res.ContentLength, maxImageBytes)
+	}
+
+	// 3. Read, refusing anything past the limit
+	data, err := io.ReadAll(io.LimitReader(res.Body, maxImageBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > maxImageBytes {
+		return nil, fmt.Errorf("%w: image is larger than %d bytes", errPermanent, maxImageBytes)
+	}
+
+	// 4. Content type (sniff when the server is vague)
+	contentType := res.Header.Get("Content-Type")
+	if contentType == "" || strings.HasPrefix(contentType, "application/octet-stream") {
+		contentType = http.DetectContentType(data)
+	}
+	if !strings.HasPrefix(contentType, "image/") && !isSVG(data) {
+		return nil, fmt.Errorf("%w: unexpected content type %q", errPermanent, contentType)
+	}
+
+	return data, nil
+}
+
+// sourceImage is one downloaded image, shared by every size and mode it
+// gets rendered at. It is decoded on first use (unchanged content whose
+// art is cached never needs it).
+type sourceImage struct {
+	Data      []byte
+	Hash      string
+	FetchedAt time.Time
+
+	once      sync.Once
+	img       image.Image
+	decodeErr error
+}
+
+// decoded decodes the image once, a panicking decoder becomes an error
+func (s *sourceImage) decoded() (image.Image, error) {
+	s.once.Do(func() {
+		defer func() {
+			if r := recover(); r != nil {
+				s.decodeErr = fmt.Errorf("panic: %v", r)
+			}
+		}()
+		s.img, s.decodeErr = decodeImage(s.Data)
+	})
+	return s.img, s.decodeErr
+}
+
+// sourceCall is a download in progress, joined by everyone after the same URL
+type sourceCall struct {
+	done chan struct{}
+	src  *sourceImage
+	err  error
+}
+
+// Downloads by URL only: sessions rendering the same image at different
+// sizes (or in different modes) share one download and one decode
+var (
+	sourceMutex sync.Mutex
+	sourceCache = map[string]*sourceImage{}
+	sourceCalls = map[string]*sourceCall{}
+	sourceTTL   = time.Minute // Long enough for every pending size to be rendered
+)
+
+// loadSource returns the downloaded image for a URL, fetching it only when
+// no recent download exists and nobody is fetching it already
+func loadSource(url string) (*sourceImage, error) {
+	sourceMutex.Lock()
+	if src, ok := sourceCache[url]; ok && time.Since(src.FetchedAt) < sourceTTL {
+		sourceMutex.Unlock()
+		return src, nil
+	}
+	if call, ok := sourceCalls[url]; ok {
+		sourceMutex.Unlock()
+		<-call.done
+		return call.src, call.err
+	}
+	call := &sourceCall{done: make(chan struct{})}
+	sourceCalls[url] = call
+	sourceMutex.Unlock()
+
+	data, err := fetchImage(url)
+	if err == nil {
+		call.src = &sourceImage{Data: data, Hash: contentHash(data), FetchedAt: time.Now()}
+	}
+	call.err = err
+
+	sourceMutex.Lock()
+	delete(sourceCalls, url)
+	if err == nil {
+		sourceCache[url] = call.src
+	}
+	// Drop old downloads so the map doesn't keep every image in memory
+	for k, src := range sourceCache {
+		if time.Since(src.FetchedAt) >= sourceTTL {
+			delete(sourceCache, k)
+		}
+	}
+	sourceMutex.Unlock()
+	close(call.done)
+	return call.src, call.err
+}
@@ -0,0 +1,259 @@
+package utils
+
+import (
+	"bytes"
+	"fmt"
+	"hash/fnv"
+	"image"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/x/ansi"
+	"github.com/charmbracelet/x/ansi/kitty"
+	"github.com/charmbracelet/x/ansi/sixel"
+	"github.com/charmbracelet/x/input"
+	"github.com/nfnt/resize"
+)
+
+// Terminals that announce bitmap support through TERM alone
+var (
+	kittyTerms = []string{"xterm-kitty", "xterm-ghostty", "ghostty"}
+	sixelTerms = []string{"foot", "foot-extra", "mlterm", "yaft-256color", "contour"}
+)
+
+// Programs that support Kitty graphics or Sixel, as reported by
+// TERM_PROGRAM / LC_TERMINAL (only present when the visitor's SSH client
+// forwards them)
+var (
+	kittyPrograms = []string{"kitty", "ghostty"}
+	sixelPrograms = []string{"wezterm", "iterm2", "mintty", "konsole", "rio"}
+)
+
+// Marker written in front of a Sixel image (save cursor + move up)
+const sixelMarker = "\x1b7\x1b["
+
+// kittyQuery asks for a 1x1 image to be checked without storing it.
+// Kitty-compatible terminals answer with "OK", everyone else ignores it.
+var kittyQuery = ansi.KittyGraphics([]byte("AAAA"), "i=31", "s=1", "v=1", "a=q", "t=d", "f=24")
+
+// imageQueryTimeout bounds how long a session waits for the terminal to answer
+const imageQueryTimeout = 500 * time.Millisecond
+
+// DetectImageMode picks the best bitmap protocol for a visitor's terminal.
+// TERM and forwarded environment variables are checked first; otherwise the
+// terminal is asked directly (Kitty graphics query + Primary Device
+// Attributes, where attribute 4 means Sixel). Anything else stays on ASCII.
+func DetectImageMode(in io.Reader, out io.Writer, term string, environ []string) ImageMode {
+	term = strings.ToLower(term)
+	if term == "" || term == "dumb" {
+		return ModeASCII
+	}
+	for _, t := range kittyTerms {
+		if term == t {
+			return ModeKitty
+		}
+	}
+	for _, t := range sixelTerms {
+		if term == t {
+			return ModeSixel
+		}
+	}
+	for _, kv := range environ {
+		key, val, _ := strings.Cut(kv, "=")
+		if key != "TERM_PROGRAM" && key != "LC_TERMINAL" {
+			continue
+		}
+		for _, p := range kittyPrograms {
+			if strings.EqualFold(val, p) {
+				return ModeKitty
+			}
+		}
+		for _, p := range sixelPrograms {
+			if strings.EqualFold(val, p) {
+				return ModeSixel
+			}
+		}
+	}
+
+	return queryImageMode(in, out, term, imageQueryTimeout)
+}
+
+// queryImageMode sends the graphics queries and waits for the DA1 reply,
+// which every terminal sends last. It runs before the program starts
+// reading the session, the same way wish asks for the background color.
+// An SSH session can't interrupt a read, so on timeout the reader is left
+// to finish (and drop) whatever arrives next instead of holding up the visitor.
+func queryImageMode(in io.Reader, out io.Writer, term string, timeout time.Duration) ImageMode {
+	rd, err := input.NewReader(in, term, 0)
+	if err != nil {
+		return ModeASCII
+	}
+
+	if _, err := io.WriteString(out, kittyQuery+ansi.RequestPrimaryDeviceAttributes); err != nil {
+		rd.Close()
+		return ModeASCII
+	}
+
+	result := make(chan ImageMode, 1)
+	go func() {
+		defer rd.Close()
+		result <- readImageReply(rd)
+	}()
+
+	select {
+	case mode := <-result:
+		return mode
+	case <-time.After(timeout):
+		rd.Cancel()
+		return ModeASCII
+	}
+}
+
+// readImageReply reads the terminal's answers up to the DA1 reply
+func readImageReply(rd *input.Reader) ImageMode {
+	mode := ModeASCII
+	for {
+		events, err := rd.ReadEvents()
+		if err != nil {
+			return mode
+		}
+		for _, e := range events {
+			switch e := e.(type) {
+			case input.KittyGraphicsEvent:
+				if e.Options.ID == 31 && string(e.Payload) == "OK" {
+					mode = ModeKitty
+				}
+			case input.PrimaryDeviceAttributesEvent:
+				for _, attr := range e {
+					if attr == 4 && mode == ModeASCII {
+						mode = ModeSixel
+					}
+				}
+				return mode
+			}
+		}
+	}
+}
+
+// renderKitty encodes an image for the Kitty protocol using Unicode
+// placeholders, so it takes part in the normal text layout: the first line
+// carries the image data, every cell is a placeholder rune whose foreground
+// color is the image ID
+func renderKitty(img image.Image, o ImageOptions) (string, error) {
+	id := kittyImageID(o)
+
+	var data bytes.Buffer
+	err := kitty.EncodeGraphics(&data, img, &kitty.Options{
+		Action:           kitty.TransmitAndPut,
+		Quite:            2,
+		ID:               id,
+		Format:           kitty.PNG,
+		Transmission:     kitty.Direct,
+		Chunk:            true,
+		VirtualPlacement: true,
+		Columns:          o.Width,
+		Rows:             o.Height,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	color := fmt.Sprintf("\x1b[38;2;%d;%d;%dm", (id>>16)&0xff, (id>>8)&0xff, id&0xff)
+
+	var out strings.Builder
+	out.Write(data.Bytes())
+	for row := 0; row < o.Height; row++ {
+		if row > 0 {
+			out.WriteByte('\n')
+		}
+		out.WriteString(color)
+		// Only the first cell needs row + column, the rest are inferred
+		out.WriteRune(kitty.Placeholder)
+		out.WriteRune(kitty.Diacritic(row))
+		out.WriteRune(kitty.Diacritic(0))
+		out.WriteString(strings.Repeat(string(kitty.Placeholder), o.Width-1))
+		out.WriteString("\x1b[39m")
+	}
+	return out.String(), nil
+}
+
+// kittyImageID derives a stable 24-bit image ID from the render options,
+// so the same picture reuses the same ID on every redraw
+func kittyImageID(o ImageOptions) int {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(o.Source + o.cacheMode()))
+	id := int(h.Sum32() & 0xffffff)
+	if id == 0 {
+		id = 1
+	}
+	return id
+}
+
+// renderSixel encodes an image as Sixel sized to the given cells. The text
+// part is a block of blank cells; the image is drawn from its last line
+// (cursor saved, moved up to the top, restored) so the blank lines written
+// before it don't erase the pixels.
+func renderSixel(img image.Image, o ImageOptions) (string, error) {
+	if o.Height < 2 {
+		return "", fmt.Errorf("sixel needs at least 2 rows, got %d", o.Height)
+	}
+	img = resize.Resize(uint(o.Width*o.CellWidth), uint(o.Height*o.CellHeight), img, resize.Bilinear)
+
+	var payload bytes.Buffer
+	if err := new(sixel.Encoder).Encode(&payload, img); err != nil {
+		return "", err
+	}
+
+	blank := strings.Repeat(" ", o.Width)
+	lines := make([]string, o.Height)
+	for i := range lines {
+		lines[i] = blank
+	}
+	lines[o.Height-1] = fmt.Sprintf("%s%dA%s\x1b8%s", sixelMarker, o.Height-1, ansi.SixelGraphics(0, 1, 0, payload.Bytes()), blank)
+
+	return strings.Join(lines, "\n"), nil
+}
+
+// IsBitmapArt reports whether rendered art uses a graphics protocol
+// (it must not be cut line by line like ASCII art)
+func IsBitmapArt(art string) bool {
+	return strings.Contains(art, "\x1b_G") || strings.Contains(art, sixelMarker)
+}
+
+// ClipSixel drops Sixel images whose top would be drawn above the first
+// line of a clipped block (e.g. a scrolled viewport), so they can't paint
+// over the header
+func ClipSixel(block string) string {
+	if !strings.Contains(block, sixelMarker) {
+		return block
+	}
+
+	lines := strings.Split(block, "\n")
+	for i, line := range lines {
+		search := 0
+		for {
+			rel := strings.Index(line[search:], sixelMarker)
+			if rel < 0 {
+				break
+			}
+			start := search + rel
+			end := strings.Index(line[start:], "\x1b8")
+			if end < 0 {
+				break
+			}
+
+			var up int
+			fmt.Sscanf(line[start+len(sixelMarker):], "%dA", &up)
+			if up > i {
+				// Not enough room above: drop the image, keep the blank cells
+				line = line[:start] + line[start+end+2:]
+				search = start
+			} else {
+				search = start + end + 2
+			}
+		}
+		lines[i] = line
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,220 @@
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"portfolioTUI/config"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// artEntry is one cached conversion, kept in memory and on disk
+type artEntry struct {
+	Art       string    `json:"art"`
+	Hash      string    `json:"hash"` // sha256 of the source image bytes
+	FetchedAt time.Time `json:"fetchedAt"`
+}
+
+// Process-wide ASCII art cache shared by every SSH session
+var (
+	artCache      = map[string]artEntry{}  // url|width|height|mode -> art
+	artByHash     = map[string]string{}    // hash|width|height|mode -> art (same image behind different URLs)
+	artUsed       = map[string]time.Time{} // Last use of every key of both maps, for evicting
+	artMutex      sync.RWMutex
+	artRevalidate = 6 * time.Hour // After this the source is re-downloaded and its hash compared
+
+	// Every terminal size and mode is its own conversion, so both caches
+	// are bounded: memory by count (least recently used go first), disk by
+	// age and count
+	artMemoryMax = 2000
+	artDiskMax   = 5000
+	artDiskTTL   = 7 * 24 * time.Hour
+	artPruneGap  = 10 * time.Minute
+	artPruned    time.Time
+)
+
+// artKey identifies a conversion of an image at a given size and color mode
+func artKey(url string, width, height int, mode string) string {
+	return fmt.Sprintf("%s|%d|%d|%s", url, width, height, mode)
+}
+
+// hashKey identifies a conversion by image content instead of URL
+func hashKey(hash string, width, height int, mode string) string {
+	return fmt.Sprintf("%s|%d|%d|%s", hash, width, height, mode)
+}
+
+// contentHash fingerprints downloaded image bytes
+func contentHash(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// lookupArt checks memory first, then disk (warming memory on a disk hit)
+func lookupArt(key string) (artEntry, bool) {
+	artMutex.Lock()
+	entry, ok := artCache[key]
+	if ok {
+		artUsed[key] = time.Now()
+	}
+	artMutex.Unlock()
+	if ok {
+		return entry, true
+	}
+
+	path := artCachePath(key)
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return artEntry{}, false
+	}
+	if err := json.Unmarshal(raw, &entry); err != nil {
+		return artEntry{}, false
+	}
+	// The file's mtime is its last use, pruneArtDir drops the old ones
+	now := time.Now()
+	_ = os.Chtimes(path, now, now)
+
+	artMutex.Lock()
+	artCache[key] = entry
+	artUsed[key] = now
+	evictArt()
+	artMutex.Unlock()
+	return entry, true
+}
+
+// lookupArtByHash finds a conversion of identical image bytes
+func lookupArtByHash(hkey string) (string, bool) {
+	artMutex.Lock()
+	defer artMutex.Unlock()
+	art, ok := artByHash[hkey]
+	if ok {
+		artUsed[hkey] = time.Now()
+	}
+	return art, ok
+}
+
+// evictArt drops the least recently used conversions once memory holds
+// more than artMemoryMax (artMutex must be held)
+func evictArt() {
+	extra := len(artUsed) - artMemoryMax
+	if extra <= 0 {
+		return
+	}
+
+	keys := make([]string, 0, len(artUsed))
+	for k := range artUsed {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return artUsed[keys[i]].Before(artUsed[keys[j]]) })
+
+	// Evict a tenth more than needed so this doesn't sort on every store
+	for _, k := range keys[:min(len(keys), extra+artMemoryMax/10)] {
+		delete(artCache, k)
+		delete(artByHash, k)
+		delete(artUsed, k)
+	}
+}
+
+// storeArt saves a conversion in memory and writes it to disk
+func storeArt(key, hkey string, entry artEntry) {
+	now := time.Now()
+	artMutex.Lock()
+	artCache[key] = entry
+	artByHash[hkey] = entry.Art
+	artUsed[key] = now
+	artUsed[hkey] = now
+	evictArt()
+	prune := now.Sub(artPruned) > artPruneGap
+	if prune {
+		artPruned = now
+	}
+	artMutex.Unlock()
+
+	if prune {
+		go pruneArtDir()
+	}
+
+	raw, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(config.ASCIICACHEDIR, 0755); err != nil {
+		log.Println("Could not create ASCII cache dir:", err)
+		return
+	}
+
+	// Write to a temp file of our own first so readers never see half a
+	// file (and sessions converting the same image don't mix their writes)
+	tmp, err := os.CreateTemp(config.ASCIICACHEDIR, "*.tmp")
+	if err != nil {
+		log.Println("Could not write ASCII cache:", err)
+		return
+	}
+	_, err = tmp.Write(raw)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		log.Println("Could not write ASCII cache:", err)
+		_ = os.Remove(tmp.Name())
+		return
+	}
+	if err := os.Rename(tmp.Name(), artCachePath(key)); err != nil {
+		_ = os.Remove(tmp.Name())
+	}
+}
+
+// pruneArtDir deletes cache files unused for artDiskTTL, then the least
+// recently used ones past artDiskMax (plus temp files left by a crash)
+func pruneArtDir() {
+	entries, err := os.ReadDir(config.ASCIICACHEDIR)
+	if err != nil {
+		return
+	}
+
+	type cacheFile struct {
+		path string
+		used time.Time
+	}
+	var files []cacheFile
+	for _, e := range entries {
+		info, err := e.Info()
+		if err != nil || e.IsDir() {
+			continue
+		}
+		path := filepath.Join(config.ASCIICACHEDIR, e.Name())
+		switch {
+		case strings.HasSuffix(e.Name(), ".tmp"):
+			if time.Since(info.ModTime()) > time.Hour {
+				_ = os.Remove(path)
+			}
+		case strings.HasSuffix(e.Name(), ".json"):
+			if time.Since(info.ModTime()) > artDiskTTL {
+				_ = os.Remove(path)
+				continue
+			}
+			files = append(files, cacheFile{path, info.ModTime()})
+		}
+	}
+
+	if len(files) <= artDiskMax {
+		return
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].used.Before(files[j].used) })
+	for _, f := range files[:len(files)-artDiskMax] {
+		_ = os.Remove(f.path)
+	}
+	log.Println("Pruned", len(files)-artDiskMax, "ASCII cache files")
+}
+
+// artCachePath is the disk location of a cache key
+func artCachePath(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(config.ASCIICACHEDIR, hex.EncodeToString(sum[:])+".json")
+}
@@ -0,0 +1,240 @@
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/charmbracelet/glamour"
+	"github.com/charmbracelet/glamour/styles"
+	"github.com/muesli/termenv"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// Rendered articles, shared by every session (rendering is slow-ish and
+// every visitor reads the same posts)
+var (
+	markdownCache    = map[string]string{} // hash|width|style -> rendered
+	markdownMutex    sync.Mutex
+	markdownCacheMax = 64
+)
+
+var (
+	htmlTagPattern = regexp.MustCompile(`(?i)<(p|div|h[1-6]|ul|ol|li|br|img|pre|blockquote|strong|em|a)[\s>/]`)
+	blankLines     = regexp.MustCompile(`\n{3,}`)
+	spaces         = regexp.MustCompile(`\s+`)
+)
+
+// IsHTML guesses whether a blog body was written in the web editor (HTML)
+// rather than in Markdown
+func IsHTML(s string) bool {
+	return htmlTagPattern.MatchString(s)
+}
+
+// RenderMarkdown renders an article for the terminal, wrapped to width.
+// HTML bodies are converted to Markdown first.
+func RenderMarkdown(body string, width int, profile termenv.Profile, dark bool) (string, error) {
+	if IsHTML(body) {
+		body = HTMLToMarkdown(body)
+	}
+
+	style := styles.LightStyle
+	switch {
+	case profile == termenv.Ascii:
+		style = styles.NoTTYStyle
+	case dark:
+		style = styles.DarkStyle
+	}
+
+	sum := sha256.Sum256([]byte(body))
+	key := fmt.Sprintf("%s|%d|%s|%d", hex.EncodeToString(sum[:]), width, style, profile)
+
+	markdownMutex.Lock()
+	out, ok := markdownCache[key]
+	markdownMutex.Unlock()
+	if ok {
+		return out, nil
+	}
+
+	r, err := glamour.NewTermRenderer(
+		glamour.WithStandardStyle(style),
+		glamour.WithColorProfile(profile),
+		glamour.WithWordWrap(width),
+		glamour.WithEmoji(),
+	)
+	if err != nil {
+		return "", err
+	}
+	out, err = r.Render(body)
+	if err != nil {
+		return "", err
+	}
+
+	markdownMutex.Lock()
+	if len(markdownCache) >= markdownCacheMax {
+		markdownCache = map[string]string{}
+	}
+	markdownCache[key] = out
+	markdownMutex.Unlock()
+	return out, nil
+}
+
+// HTMLToMarkdown converts the HTML of the web editor into Markdown:
+// headings, paragraphs, emphasis, links, images, lists, quotes and code.
+// Anything else keeps just its text.
+func HTMLToMarkdown(src string) string {
+	doc, err := html.Parse(strings.NewReader(src))
+	if err != nil {
+		return src
+	}
+	var c mdConverter
+	out := blankLines.ReplaceAllString(c.node(doc), "\n\n")
+	return strings.TrimSpace(out) + "\n"
+}
+
+// mdConverter walks the HTML tree
+type mdConverter struct {
+	pre bool // Inside <pre>, whitespace is kept as-is
+}
+
+func (c *mdConverter) children(n *html.Node) string {
+	var b strings.Builder
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		b.WriteString(c.node(child))
+	}
+	return b.String()
+}
+
+func (c *mdConverter) node(n *html.Node) string {
+	switch n.Type {
+	case html.TextNode:
+		if c.pre {
+			return n.Data
+		}
+		return spaces.ReplaceAllString(n.Data, " ")
+	case html.ElementNode:
+	default:
+		return c.children(n)
+	}
+
+	switch n.DataAtom {
+	case atom.Script, atom.Style, atom.Head:
+		return ""
+
+	case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6:
+		level := int(n.Data[1] - '0')
+		return "\n\n" + strings.Repeat("#", level) + " " + strings.TrimSpace(c.children(n)) + "\n\n"
+
+	case atom.P, atom.Div, atom.Section, atom.Article, atom.Figure:
+		return "\n\n" + strings.TrimSpace(c.children(n)) + "\n\n"
+
+	case atom.Br:
+		return "  \n"
+
+	case atom.Hr:
+		return "\n\n---\n\n"
+
+	case atom.Strong, atom.B:
+		return wrapInline(c.children(n), "**")
+
+	case atom.Em, atom.I:
+		return wrapInline(c.children(n), "_")
+
+	case atom.Del, atom.S:
+		return wrapInline(c.children(n), "~~")
+
+	case atom.Code:
+		if c.pre {
+			return c.children(n)
+		}
+		return wrapInline(c.children(n), "`")
+
+	case atom.Pre:
+		c.pre = true
+		body := c.children(n)
+		c.pre = false
+		return "\n\n```" + codeLanguage(n) + "\n" + strings.Trim(body, "\n") + "\n```\n\n"
+
+	case atom.A:
+		href := attr(n, "href")
+		text := strings.TrimSpace(c.children(n))
+		if href == "" {
+			return text
+		}
+		if text == "" {
+			text = href
+		}
+		return "[" + text + "](" + href + ")"
+
+	case atom.Img:
+		if src := attr(n, "src"); src != "" {
+			return "![" + attr(n, "alt") + "](" + src + ")"
+		}
+		return ""
+
+	case atom.Ul, atom.Ol:
+		var b strings.Builder
+		number := 1
+		for li := n.FirstChild; li != nil; li = li.NextSibling {
+			if li.DataAtom != atom.Li {
+				continue
+			}
+			marker := "- "
+			if n.DataAtom == atom.Ol {
+				marker = fmt.Sprintf("%d. ", number)
+				number++
+			}
+			// Continuation lines (nested lists...) line up under the text
+			item := strings.TrimSpace(blankLines.ReplaceAllString(c.children(li), "\n\n"))
+			item = strings.ReplaceAll(item, "\n", "\n"+strings.Repeat(" ", len(marker)))
+			b.WriteString(marker + item + "\n")
+		}
+		return "\n\n" + b.String() + "\n"
+
+	case atom.Blockquote:
+		body := strings.TrimSpace(blankLines.ReplaceAllString(c.children(n), "\n\n"))
+		return "\n\n> " + strings.ReplaceAll(body, "\n", "\n> ") + "\n\n"
+	}
+
+	return c.children(n)
+}
+
+// wrapInline puts Markdown markers around text, outside its surrounding
+// spaces ("** bold **" isn't bold)
+func wrapInline(s, marker string) string {
+	trimmed := strings.TrimSpace(s)
+	if trimmed == "" {
+		return s
+	}
+	lead := s[:strings.Index(s, trimmed)]
+	trail := s[len(lead)+len(trimmed):]
+	return lead + marker + trimmed + marker + trail
+}
+
+// codeLanguage reads "language-go" style classes of a <pre> or its <code>
+func codeLanguage(n *html.Node) string {
+	classes := attr(n, "class")
+	if code := n.FirstChild; code != nil && code.DataAtom == atom.Code {
+		classes += " " + attr(code, "class")
+	}
+	for _, class := range strings.Fields(classes) {
+		if lang, ok := strings.CutPrefix(class, "language-"); ok {
+			return lang
+		}
+	}
+	return ""
+}
+
+// attr returns an attribute of an HTML element
+func attr(n *html.Node, name string) string {
+	for _, a := range n.Attr {
+		if a.Key == name {
+			return a.Val
+		}
+	}
+	return ""
+}
@@ -0,0 +1,173 @@
+package utils
+
+import (
+	"container/heap"
+	"fmt"
+	"log"
+	"portfolioTUI/config"
+	"sync"
+)
+
+// Image priorities (higher runs first)
+const (
+	PriorityBackground = 0 // Cards on a tab the visitor isn't looking at
+	PriorityVisible    = 1 // Cards on the visible tab
+)
+
+// imageJob is one conversion, shared by every session that asks for the
+// same image (URL, size and mode) while it is pending. The download itself
+// is shared by URL only (see loadSource).
+type imageJob struct {
+	key      string
+	source   string // Image URL, to find the job again when its tab shows up
+	work     func() (any, error)
+	priority int
+	seq      uint64 // FIFO order within a priority
+	index    int    // Position in the queue, -1 once a worker took it
+
+	done   chan struct{} // Closed when result / err are set
+	result any
+	err    error
+}
+
+// jobQueue is a heap of pending jobs: highest priority, then oldest first
+type jobQueue []*imageJob
+
+func (q jobQueue) Len() int { return len(q) }
+
+func (q jobQueue) Less(i, j int) bool {
+	if q[i].priority != q[j].priority {
+		return q[i].priority > q[j].priority
+	}
+	return q[i].seq < q[j].seq
+}
+
+func (q jobQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index = i
+	q[j].index = j
+}
+
+func (q *jobQueue) Push(x any) {
+	job := x.(*imageJob)
+	job.index = len(*q)
+	*q = append(*q, job)
+}
+
+func (q *jobQueue) Pop() any {
+	old := *q
+	job := old[len(old)-1]
+	old[len(old)-1] = nil
+	job.index = -1
+	*q = old[:len(old)-1]
+	return job
+}
+
+// Global Image Pool (server-wide, config.IMAGEWORKERS workers)
+var (
+	poolMutex sync.Mutex
+	poolCond  = sync.NewCond(&poolMutex)
+	poolQueue jobQueue
+	poolJobs  = map[string]*imageJob{} // Queued or running jobs by cache key
+	poolSeq   uint64
+	poolStart sync.Once
+)
+
+// runImageJob queues an image on the shared pool and waits for its art
+func runImageJob(opts ImageOptions, priority int) (string, error) {
+	key := artKey(opts.Source, opts.Width, opts.Height, opts.cacheMode())
+	result, err := runPooled(key, opts.Source, priority, func() (any, error) {
+		return cachedArt(opts)
+	})
+	art, _ := result.(string)
+	return art, err
+}
+
+// runPooled queues work on the shared pool and waits for its result.
+// A request with the key of a job that is already pending joins that job
+// instead of converting again, raising its priority if needed.
+func runPooled(key, source string, priority int, work func() (any, error)) (any, error) {
+	poolStart.Do(startImageWorkers)
+
+	poolMutex.Lock()
+	job, ok := poolJobs[key]
+	if ok {
+		if job.index >= 0 && priority > job.priority {
+			job.priority = priority
+			heap.Fix(&poolQueue, job.index)
+		}
+	} else {
+		poolSeq++
+		job = &imageJob{
+			key:      key,
+			source:   source,
+			work:     work,
+			priority: priority,
+			seq:      poolSeq,
+			done:     make(chan struct{}),
+		}
+		poolJobs[key] = job
+		heap.Push(&poolQueue, job)
+		poolCond.Signal()
+	}
+	poolMutex.Unlock()
+
+	<-job.done
+	return job.result, job.err
+}
+
+// PrioritizeImages moves the queued jobs of these image URLs ahead of the
+// background ones (their tab just came on screen)
+func PrioritizeImages(sources []string) {
+	wanted := map[string]bool{}
+	for _, s := range sources {
+		wanted[s] = true
+	}
+
+	poolMutex.Lock()
+	defer poolMutex.Unlock()
+	// (Fixing the heap reorders the queue, so walk a copy)
+	for _, job := range append(jobQueue(nil), poolQueue...) {
+		if wanted[job.source] && job.priority < PriorityVisible {
+			job.priority = PriorityVisible
+			heap.Fix(&poolQueue, job.index)
+		}
+	}
+}
+
+// startImageWorkers launches the pool once, on the first request
+func startImageWorkers() {
+	for i := 0; i < max(config.IMAGEWORKERS, 1); i++ {
+		go imageWorker()
+	}
+}
+
+// imageWorker runs queued jobs forever
+func imageWorker() {
+	for {
+		poolMutex.Lock()
+		for len(poolQueue) == 0 {
+			poolCond.Wait()
+		}
+		job := heap.Pop(&poolQueue).(*imageJob)
+		poolMutex.Unlock()
+
+		job.result, job.err = safeRun(job)
+
+		poolMutex.Lock()
+		delete(poolJobs, job.key)
+		poolMutex.Unlock()
+		close(job.done)
+	}
+}
+
+// safeRun keeps a worker alive when a decoder panics
+func safeRun(job *imageJob) (result any, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Println("panic generating image", job.key, r)
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return job.work()
+}
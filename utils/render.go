@@ -0,0 +1,152 @@
+package utils
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"strings"
+
+	"github.com/muesli/termenv"
+	"github.com/nfnt/resize"
+	"github.com/qeesung/image2ascii/convert"
+)
+
+// ImageMode selects how a picture is turned into terminal output
+type ImageMode string
+
+const (
+	ModeASCII     ImageMode = "ascii"     // image2ascii characters (works everywhere)
+	ModeHalfBlock ImageMode = "halfblock" // ▀ with foreground + background colors
+	ModeSixel     ImageMode = "sixel"     // Sixel bitmaps
+	ModeKitty     ImageMode = "kitty"     // Kitty graphics protocol
+)
+
+// ImageOptions describes one render of an image
+type ImageOptions struct {
+	Source string // Image URL
+
+	Width, Height int // Size in terminal cells
+	Mode          ImageMode
+	Colored       bool // ASCII only: colored characters
+
+	// Colors the visitor's terminal can show (ASCII / half blocks)
+	Profile termenv.Profile
+
+	// Pixel size of one terminal cell (bitmap modes)
+	CellWidth, CellHeight int
+}
+
+// cacheMode is the part of the cache key that depends on how we render
+func (o ImageOptions) cacheMode() string {
+	switch o.Mode {
+	case ModeSixel:
+		return fmt.Sprintf("sixel-%dx%d", o.CellWidth, o.CellHeight)
+	case ModeKitty:
+		return "kitty"
+	case ModeHalfBlock:
+		return fmt.Sprintf("halfblock-%d", o.Profile)
+	}
+	if o.Colored && o.Profile != termenv.Ascii {
+		return "color"
+	}
+	return "mono"
+}
+
+// renderImage converts a decoded image for the requested mode.
+// Bitmap modes fall back to ASCII if encoding fails.
+func renderImage(img image.Image, o ImageOptions) string {
+	var (
+		art string
+		err error
+	)
+
+	switch o.Mode {
+	case ModeKitty:
+		art, err = renderKitty(img, o)
+	case ModeSixel:
+		art, err = renderSixel(img, o)
+	case ModeHalfBlock:
+		// Half blocks are nothing without colors
+		if o.Profile == termenv.Ascii {
+			return renderASCII(img, o)
+		}
+		return renderHalfBlock(img, o)
+	default:
+		return renderASCII(img, o)
+	}
+
+	if err != nil {
+		return renderASCII(img, o)
+	}
+	return art
+}
+
+// renderASCII is the original image2ascii conversion
+func renderASCII(img image.Image, o ImageOptions) string {
+	convertOptions := convert.DefaultOptions
+	convertOptions.FixedWidth = o.Width
+	convertOptions.FixedHeight = o.Height
+	convertOptions.Colored = o.Colored && o.Profile != termenv.Ascii
+	convertOptions.Ratio = 0.5 // Best for thumbnails
+
+	converter := convert.NewImageConverter()
+	return converter.Image2ASCIIString(img, &convertOptions)
+}
+
+// renderHalfBlock draws two pixels per cell: the upper one as the
+// foreground of "▀", the lower one as its background. The image keeps its
+// aspect ratio inside Width x Height cells; transparent pixels are left to
+// the terminal background.
+func renderHalfBlock(img image.Image, o ImageOptions) string {
+	b := img.Bounds()
+	if b.Dx() == 0 || b.Dy() == 0 || o.Width <= 0 || o.Height <= 0 {
+		return ""
+	}
+
+	// Fit into Width x (Height*2) pixels
+	w, h := o.Width, b.Dy()*o.Width/b.Dx()
+	if h > o.Height*2 {
+		w, h = b.Dx()*o.Height*2/b.Dy(), o.Height*2
+	}
+	w, h = max(w, 1), max(h, 1)
+	img = resize.Resize(uint(w), uint(h), img, resize.Bilinear)
+	b = img.Bounds()
+
+	var out strings.Builder
+	for y := 0; y < h; y += 2 {
+		if y > 0 {
+			out.WriteByte('\n')
+		}
+		for x := 0; x < w; x++ {
+			top := img.At(b.Min.X+x, b.Min.Y+y)
+			var bottom color.Color = color.Transparent
+			if y+1 < h {
+				bottom = img.At(b.Min.X+x, b.Min.Y+y+1)
+			}
+			out.WriteString(halfBlockCell(top, bottom, o.Profile))
+		}
+		out.WriteString("\x1b[0m")
+	}
+	return out.String()
+}
+
+// halfBlockCell renders one cell from its upper and lower pixel
+func halfBlockCell(top, bottom color.Color, p termenv.Profile) string {
+	topSeen, bottomSeen := isOpaque(top), isOpaque(bottom)
+
+	switch {
+	case topSeen && bottomSeen:
+		return fmt.Sprintf("\x1b[0;%s;%sm▀", p.FromColor(top).Sequence(false), p.FromColor(bottom).Sequence(true))
+	case topSeen:
+		return fmt.Sprintf("\x1b[0;%sm▀", p.FromColor(top).Sequence(false))
+	case bottomSeen:
+		return fmt.Sprintf("\x1b[0;%sm▄", p.FromColor(bottom).Sequence(false))
+	}
+	return "\x1b[0m "
+}
+
+// isOpaque treats mostly transparent pixels as background
+func isOpaque(c color.Color) bool {
+	_, _, _, a := c.RGBA()
+	return a >= 0x8000
+}
@@ -168,54 +168,56 @@ func FetchData() tea.Cmd {
 	}
 }
 
-// submitContactCmd unpacks the form struct and calls the DB function
-func submitContactCmd(form database.ContactSchema) tea.Cmd {
-	return func() tea.Msg {
-		// 1. Simulate Delay
-		time.Sleep(1 * time.Second)
-
-		// 2. Handle the ServiceID pointer safely
-		// The struct has *string, but InsertContact expects a string ID
-		svcID := ""
-		if form.ServiceId != nil {
-			svcID = *form.ServiceId
-		}
+// ImageSource is the URL of an item's card image (the default image when
+// it has none), false for collections without pictures
+func ImageSource(dataType string, item bson.M) (string, bool) {
+	var key string
+
+	// 1. Determine the correct key for this collection
+	switch dataType {
+	case "projects":
+		key = "imageUrl"
+	case "positions":
+		key = "logoUrl" // Ensure this matches your DB
+	case "blogs":
+		key = "featuredImage" // Ensure this matches your DB
+	default:
+		return "", false
+	}
 
-		// 3. Call the DB function with individual fields
-		err := database.InsertContact(
-			form.FirstName,
-			form.LastName,
-			form.Email,
-			form.Type,
-			form.Description,
-			svcID,
-		)
-
-		if err != nil {
-			return config.FormSubmittedMsg{Success: false}
-		}
-		return config.FormSubmittedMsg{Success: true}
+	// 2. Safe Get: Get the string, or "" if missing
+	url, _ := item[key].(string)
+
+	// 3. Fallback Logic (The Fix)
+	// If URL is empty OR too short, use the default
+	if len(url) < 5 {
+		url = config.DEFAULTIMAGEURL
 	}
+	return url, true
 }
 
-func GenerateImagesCmds(dataType string, data []bson.M) []tea.Cmd {
+// GenerateImagesCmds renders every image of a collection. render carries the
+// session's mode (ASCII / Sixel / Kitty); sizes are picked per collection.
+func GenerateImagesCmds(dataType string, data []bson.M, render ImageOptions, priority int) []tea.Cmd {
 	var cmds []tea.Cmd
-	defaultImage := config.DEFAULTIMAGEURL
 
 	for i, item := range data {
-		var url string
-		var key string
 		var targetWidth int
 		var targetHeight int
 
-		switch dataType {
-		case "projects":
+		// Callers size the art from their card layout; these are the
+		// defaults for when no layout is known yet
+		switch {
+		case render.Width > 0 && render.Height > 0:
+			targetWidth = render.Width
+			targetHeight = render.Height
+		case dataType == "projects":
 			targetWidth = 32
 			targetHeight = 15
-		case "positions":
+		case dataType == "positions":
 			targetWidth = 30
 			targetHeight = 15
-		case "blogs":
+		case dataType == "blogs":
 			targetWidth = 40
 			targetHeight = 12
 		default:
@@ -224,32 +226,18 @@ func GenerateImagesCmds(dataType string, data []bson.M) []tea.Cmd {
 
 		}
 
-		// 1. Determine the correct key for this collection
-		switch dataType {
-		case "projects":
-			key = "imageUrl"
-		case "positions":
-			key = "logoUrl" // Ensure this matches your DB
-		case "blogs":
-			key = "featuredImage" // Ensure this matches your DB
-		default:
+		url, ok := ImageSource(dataType, item)
+		if !ok {
 			continue
 		}
 
-		// 2. Safe Get: Get the string, or "" if missing
-		if val, ok := item[key].(string); ok {
-			url = val
-		}
-
-		// 3. Fallback Logic (The Fix)
-		// If URL is empty OR too short, use the default
-		if len(url) < 5 {
-			url = defaultImage
-		}
-
 		// 4. Generate the Command
 		// Now we always have a valid URL (either original or default)
-		cmds = append(cmds, GenerateAsciiImage(url, dataType, i, targetWidth, targetHeight))
+		opts := render
+		opts.Source = url
+		opts.Width = targetWidth
+		opts.Height = targetHeight
+		cmds = append(cmds, GenerateAsciiImage(dataType, i, opts, priority))
 	}
 	return cmds
 }